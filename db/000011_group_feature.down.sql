@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "group_feature";
\ No newline at end of file
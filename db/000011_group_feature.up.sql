@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "group_feature" (
+  group_id int NOT NULL,
+  "key" text NOT NULL,
+  enabled boolean NOT NULL,
+  updated_at timestamp NOT NULL DEFAULT now(),
+  -- Constraints
+  CONSTRAINT group_feature_pk PRIMARY KEY (group_id, "key"),
+  CONSTRAINT group_feature_group_fk FOREIGN KEY (group_id) REFERENCES "group" (id) ON DELETE CASCADE
+);
\ No newline at end of file
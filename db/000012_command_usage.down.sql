@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "command_usage";
\ No newline at end of file
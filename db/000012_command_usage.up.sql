@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "command_usage" (
+  id serial NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+  -- Dispatched command
+  command text NOT NULL,
+  alias text NOT NULL,
+  subcommand text,
+  -- Where it came from
+  group_id int,
+  contact_id int,
+  -- Result
+  duration_ms int NOT NULL,
+  outcome text NOT NULL,
+  error text,
+  -- Constraints
+  CONSTRAINT command_usage_pk PRIMARY KEY (id),
+  CONSTRAINT command_usage_group_fk FOREIGN KEY (group_id) REFERENCES "group" (id) ON DELETE SET NULL,
+  CONSTRAINT command_usage_contact_fk FOREIGN KEY (contact_id) REFERENCES contact (id) ON DELETE SET NULL
+);
+
+CREATE INDEX "command_usage_created_at_idx" ON "command_usage"("created_at");
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS "contact" DROP COLUMN IF EXISTS "is_mention_trigger_allowed", DROP COLUMN IF EXISTS "prefixes";
+ALTER TABLE IF EXISTS "group_settings" DROP COLUMN IF EXISTS "is_mention_trigger_allowed", DROP COLUMN IF EXISTS "prefixes";
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE IF EXISTS "group_settings"
+ADD COLUMN IF NOT EXISTS "prefixes" TEXT[],
+ADD COLUMN IF NOT EXISTS "is_mention_trigger_allowed" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE IF EXISTS "contact"
+ADD COLUMN IF NOT EXISTS "prefixes" TEXT[],
+ADD COLUMN IF NOT EXISTS "is_mention_trigger_allowed" BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
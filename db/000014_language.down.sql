@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS "contact" DROP COLUMN IF EXISTS "language";
+ALTER TABLE IF EXISTS "group_settings" DROP COLUMN IF EXISTS "language";
\ No newline at end of file
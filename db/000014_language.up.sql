@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS "group_settings"
+ADD COLUMN IF NOT EXISTS "language" TEXT NOT NULL DEFAULT '';
+ALTER TABLE IF EXISTS "contact"
+ADD COLUMN IF NOT EXISTS "language" TEXT NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,10 @@
+ALTER TABLE IF EXISTS "group_settings"
+ADD COLUMN IF NOT EXISTS "is_game_allowed" BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS "is_confess_allowed" BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE "group_settings" gs SET is_game_allowed = gf.enabled
+FROM "group_feature" gf WHERE gf.group_id = gs.id AND gf."key" = 'game';
+UPDATE "group_settings" gs SET is_confess_allowed = gf.enabled
+FROM "group_feature" gf WHERE gf.group_id = gs.id AND gf."key" = 'confess';
+
+DELETE FROM "group_feature" WHERE "key" IN ('game', 'confess');
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Only keep values that differ from the feature defaults
+INSERT INTO "group_feature" (group_id, "key", enabled)
+SELECT id, 'game', TRUE FROM "group_settings" WHERE is_game_allowed
+ON CONFLICT DO NOTHING;
+INSERT INTO "group_feature" (group_id, "key", enabled)
+SELECT id, 'confess', TRUE FROM "group_settings" WHERE is_confess_allowed
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE IF EXISTS "group_settings"
+DROP COLUMN IF EXISTS "is_game_allowed",
+DROP COLUMN IF EXISTS "is_confess_allowed";
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "blocklist" (
+  id serial NOT NULL,
+  created_at timestamp NOT NULL DEFAULT now(),
+  -- Contact JID, LID or phone number
+  jid text NOT NULL,
+  -- Constraints
+  CONSTRAINT blocklist_pk PRIMARY KEY (id),
+  CONSTRAINT blocklist_jid_unique UNIQUE (jid)
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS "blocklist"
+DROP COLUMN IF EXISTS "reason",
+DROP COLUMN IF EXISTS "expires_at";
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- jid now holds group JIDs as well
+ALTER TABLE IF EXISTS "blocklist"
+ADD COLUMN IF NOT EXISTS "reason" text NOT NULL DEFAULT '',
+-- NULL means forever
+ADD COLUMN IF NOT EXISTS "expires_at" timestamp;
\ No newline at end of file
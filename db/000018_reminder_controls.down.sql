@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "class_reminder_snooze";
+
+DROP TABLE IF EXISTS "class_reminder_message";
+
+DROP TABLE IF EXISTS "reminder_setting";
\ No newline at end of file
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS "reminder_setting" (
+  jid text NOT NULL,
+  -- Every reminder is skipped until turned back on
+  muted boolean NOT NULL DEFAULT FALSE,
+  -- Reminders due before this are skipped
+  paused_until timestamptz,
+  -- Constraints
+  CONSTRAINT reminder_setting_pk PRIMARY KEY (jid)
+);
+
+-- Sent reminders, so a reaction can find what to send again
+CREATE TABLE IF NOT EXISTS "class_reminder_message" (
+  message_id text NOT NULL,
+  jid text NOT NULL,
+  content text NOT NULL,
+  sent_at timestamptz NOT NULL DEFAULT now(),
+  -- Constraints
+  CONSTRAINT class_reminder_message_pk PRIMARY KEY (message_id)
+);
+
+CREATE INDEX IF NOT EXISTS class_reminder_message_sent_at_idx ON "class_reminder_message" (sent_at);
+
+CREATE TABLE IF NOT EXISTS "class_reminder_snooze" (
+  -- The snoozed reminder message, reacting again replaces the snooze
+  message_id text NOT NULL,
+  jid text NOT NULL,
+  content text NOT NULL,
+  due_at_unix bigint NOT NULL,
+  -- Constraints
+  CONSTRAINT class_reminder_snooze_pk PRIMARY KEY (message_id)
+);
+
+CREATE INDEX IF NOT EXISTS class_reminder_snooze_due_at_unix_idx ON "class_reminder_snooze" (due_at_unix);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "schedule_digest";
\ No newline at end of file
@@ -0,0 +1,8 @@
+-- Users who opted in to the timetable digests
+CREATE TABLE IF NOT EXISTS "schedule_digest" (
+  jid text NOT NULL,
+  daily boolean NOT NULL DEFAULT FALSE,
+  weekly boolean NOT NULL DEFAULT FALSE,
+  -- Constraints
+  CONSTRAINT schedule_digest_pk PRIMARY KEY (jid)
+);
\ No newline at end of file
@@ -2,61 +2,282 @@ package config
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io/fs"
+	"kano/internal/logger"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/joho/godotenv"
 	_ "github.com/joho/godotenv/autoload"
+	"github.com/netresearch/go-cron"
 	"go.mau.fi/whatsmeow/types"
 )
 
+const (
+	DefaultUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/137.0.0.0 Safari/537.36"
+
+	defaultTimezone         = "Asia/Jakarta"
+	defaultLogRetentionDays = 14
+	defaultDownloadMaxMB    = 64
+	defaultHandlerTimeout   = 5 * time.Minute
+	defaultCronSixReminder  = "*/10 * * * * *"
+	defaultCronSixUpdate    = "@hourly"
+	defaultCronSixDaily     = "0 6 * * *"
+	defaultCronSixWeekly    = "0 19 * * 0"
+)
+
+var defaultPrefixes = []string{"/", "!", "."}
+
+// Used by the scheduler too, so schedules are validated with the same rules
+// they run with
+var CronParser = cron.NewParser(
+	cron.SecondOptional | cron.Minute | cron.Hour |
+		cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
 type Config struct {
 	DatabaseURL   string
 	OwnerJID      types.JID
 	PddiktiKey    []byte
 	PddiktiIv     []byte
 	OwnerOnlyMode bool
+
+	UserAgent string
+	Prefixes  []string
+	Timezone  *time.Location
+
+	LogLevel         logger.LogLevel
+	LogLevels        map[string]logger.LogLevel
+	LogRetentionDays int
+
+	CronSixReminder string
+	CronSixUpdate   string
+	// Daily and weekly timetable digests, in Timezone
+	CronSixDaily  string
+	CronSixWeekly string
+
+	DownloadMaxBytes int64
+
+	// Deadline of handling a single event, commands included. `six update`
+	// and `admin broadcast` continue in the background under their own one.
+	HandlerTimeout time.Duration
+
+	// Overrides the default of group features by key
+	FeatureDefaults map[string]bool
+
+	// Status server, disabled when the address is empty
+	AdminHTTPAddr  string
+	AdminHTTPToken string
 }
 
+// Exits with every config error listed, there is nothing to recover from
+// at this point
 func InitConfig() *Config {
-	conf := Config{}
+	conf, err := LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration:\n%s\n", err)
+		os.Exit(1)
+	}
+	return conf
+}
 
-	databaseUrl, err := getEnv("DATABASE_URL")
+// Environment variables win over the config file, which wins over the
+// defaults. Every invalid value is reported, not only the first one.
+func LoadConfig() (*Config, error) {
+	file, path, err := readConfigFile()
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	l := loader{}
+	conf := Config{}
+
+	if databaseUrl, ok := l.value("DATABASE_URL", file.DatabaseURL); ok {
+		conf.DatabaseURL = databaseUrl
+	} else {
+		l.fail("DATABASE_URL", "is required")
 	}
-	conf.DatabaseURL = databaseUrl
 
-	if ownerJid, err := getEnv("OWNER_JID"); err == nil {
+	if ownerJid, ok := l.value("OWNER_JID", file.OwnerJID); ok {
 		conf.OwnerJID, err = types.ParseJID(ownerJid)
 		if err != nil {
-			panic(err)
+			l.fail("OWNER_JID", "%s", err)
+		}
+	}
+
+	pddiktiKey, hasKey := l.value("PDDIKTI_KEY", file.PddiktiKey)
+	pddiktiIv, hasIv := l.value("PDDIKTI_IV", file.PddiktiIv)
+	if hasKey && hasIv {
+		keyByte, kerr := base64.StdEncoding.DecodeString(pddiktiKey)
+		ivByte, ierr := base64.StdEncoding.DecodeString(pddiktiIv)
+		if kerr != nil {
+			l.fail("PDDIKTI_KEY", "not valid base64: %s", kerr)
+		}
+		if ierr != nil {
+			l.fail("PDDIKTI_IV", "not valid base64: %s", ierr)
+		}
+		conf.PddiktiKey = keyByte
+		conf.PddiktiIv = ivByte
+	} else if hasKey != hasIv {
+		l.fail("PDDIKTI_KEY", "PDDIKTI_KEY and PDDIKTI_IV must be given together")
+	}
+
+	if ownerOnly, ok := l.value("OWNER_ONLY", file.OwnerOnly); ok {
+		conf.OwnerOnlyMode, err = strconv.ParseBool(ownerOnly)
+		if err != nil {
+			l.fail("OWNER_ONLY", "must be true or false, got %q", ownerOnly)
 		}
 	}
 
-	if pddiktiKey, err := getEnv("PDDIKTI_KEY"); err == nil {
-		if pddiktiIv, err := getEnv("PDDIKTI_IV"); err == nil {
-			keyByte, kerr := base64.StdEncoding.DecodeString(pddiktiKey)
-			ivByte, ierr := base64.StdEncoding.DecodeString(pddiktiIv)
-			if kerr == nil && ierr == nil {
-				conf.PddiktiKey = keyByte
-				conf.PddiktiIv = ivByte
+	conf.UserAgent = DefaultUserAgent
+	if userAgent, ok := l.value("USER_AGENT", file.UserAgent); ok {
+		conf.UserAgent = userAgent
+	}
+
+	conf.Prefixes = defaultPrefixes
+	if prefixes, ok := l.value("PREFIXES", strings.Join(file.Prefixes, ",")); ok {
+		conf.Prefixes = nil
+		for p := range strings.SplitSeq(prefixes, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				conf.Prefixes = append(conf.Prefixes, p)
 			}
 		}
+		if len(conf.Prefixes) == 0 {
+			l.fail("PREFIXES", "at least one prefix is required")
+		}
 	}
 
-	if ownerOnly, err := getEnv("OWNER_ONLY"); err == nil {
-		if ownerOnly == "true" {
-			conf.OwnerOnlyMode = true
+	timezone, _ := l.value("TIMEZONE", file.Timezone)
+	if timezone == "" {
+		timezone = defaultTimezone
+	}
+	conf.Timezone, err = time.LoadLocation(timezone)
+	if err != nil {
+		l.fail("TIMEZONE", "%s", err)
+	}
+
+	conf.LogLevel = logger.LogLevelDebug
+	if levels, ok := l.value("LOG_LEVEL", file.Log.Level); ok {
+		conf.LogLevel, conf.LogLevels, err = logger.ParseLevels(levels)
+		if err != nil {
+			l.fail("LOG_LEVEL", "%s", err)
 		}
 	}
 
-	return &conf
+	conf.LogRetentionDays = defaultLogRetentionDays
+	if retention, ok := l.value("LOG_RETENTION_DAYS", file.Log.RetentionDays); ok {
+		conf.LogRetentionDays, err = strconv.Atoi(retention)
+		if err != nil || conf.LogRetentionDays < 0 {
+			l.fail("LOG_RETENTION_DAYS", "must be a number of days, got %q", retention)
+		}
+	}
+
+	conf.CronSixReminder = l.schedule("CRON_SIX_REMINDER", file.Cron.SixReminder, defaultCronSixReminder)
+	conf.CronSixUpdate = l.schedule("CRON_SIX_UPDATE", file.Cron.SixUpdate, defaultCronSixUpdate)
+	conf.CronSixDaily = l.schedule("CRON_SIX_DAILY", file.Cron.SixDaily, defaultCronSixDaily)
+	conf.CronSixWeekly = l.schedule("CRON_SIX_WEEKLY", file.Cron.SixWeekly, defaultCronSixWeekly)
+
+	conf.DownloadMaxBytes = defaultDownloadMaxMB << 20
+	if maxMB, ok := l.value("DOWNLOAD_MAX_MB", file.Download.MaxMB); ok {
+		mb, err := strconv.ParseInt(maxMB, 10, 64)
+		if err != nil || mb <= 0 {
+			l.fail("DOWNLOAD_MAX_MB", "must be a positive number of megabytes, got %q", maxMB)
+		}
+		conf.DownloadMaxBytes = mb << 20
+	}
+
+	conf.HandlerTimeout = defaultHandlerTimeout
+	if timeout, ok := l.value("HANDLER_TIMEOUT", file.HandlerTimeout); ok {
+		conf.HandlerTimeout, err = time.ParseDuration(timeout)
+		if err != nil || conf.HandlerTimeout <= 0 {
+			l.fail("HANDLER_TIMEOUT", "must be a positive duration like 90s or 5m, got %q", timeout)
+		}
+	}
+
+	conf.FeatureDefaults = file.Features
+	if features, ok := l.value("FEATURE_DEFAULTS", ""); ok {
+		conf.FeatureDefaults = map[string]bool{}
+		for part := range strings.SplitSeq(features, ",") {
+			key, state, _ := strings.Cut(strings.TrimSpace(part), "=")
+			enabled, ok := parseSwitch(state)
+			if key == "" || !ok {
+				l.fail("FEATURE_DEFAULTS", "expected key=on or key=off, got %q", part)
+				continue
+			}
+			conf.FeatureDefaults[strings.ToLower(key)] = enabled
+		}
+	}
+
+	conf.AdminHTTPAddr, _ = l.value("ADMIN_HTTP_ADDR", file.AdminHTTP.Addr)
+	conf.AdminHTTPToken, _ = l.value("ADMIN_HTTP_TOKEN", file.AdminHTTP.Token)
+
+	if err := errors.Join(l.errs...); err != nil {
+		if path != "" {
+			err = fmt.Errorf("%w\n(config file: %s)", err, path)
+		}
+		return nil, err
+	}
+	return &conf, nil
 }
 
-func getEnv(key string) (string, error) {
-	if value, exists := os.LookupEnv(key); exists {
-		return value, nil
+// Re-reads .env, the config file and the environment. The current config is
+// kept if the new one is invalid.
+func Reload() error {
+	if err := godotenv.Overload(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
 	}
 
-	return "", fmt.Errorf("cannot find %s in your environment", key)
+	conf, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+	apply(conf)
+	return nil
+}
+
+// Changes OwnerOnlyMode until the next reload or restart
+func SetOwnerOnlyMode(enabled bool) {
+	conf := *GetConfig()
+	conf.OwnerOnlyMode = enabled
+	configObj.Store(&conf)
+}
+
+type loader struct {
+	errs []error
+}
+
+// Empty values count as unset, .env.example leaves most of them empty
+func (l *loader) value(env, fromFile string) (string, bool) {
+	if value, exists := os.LookupEnv(env); exists && value != "" {
+		return value, true
+	}
+	return fromFile, fromFile != ""
+}
+
+func (l *loader) fail(env, format string, args ...any) {
+	l.errs = append(l.errs, fmt.Errorf("- %s: %s", env, fmt.Sprintf(format, args...)))
+}
+
+func (l *loader) schedule(env, fromFile, def string) string {
+	spec, ok := l.value(env, fromFile)
+	if !ok {
+		return def
+	}
+	if _, err := CronParser.Parse(spec); err != nil {
+		l.fail(env, "invalid cron schedule %q: %s", spec, err)
+	}
+	return spec
+}
+
+func parseSwitch(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "on", "true", "enable", "1":
+		return true, true
+	case "off", "false", "disable", "0":
+		return false, true
+	}
+	return false, false
 }
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"kano/internal/logger"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Every variable LoadConfig reads, cleared so the machine's environment
+// doesn't leak into the cases
+var configEnv = []string{
+	"DATABASE_URL", "OWNER_JID", "PDDIKTI_KEY", "PDDIKTI_IV", "OWNER_ONLY",
+	"USER_AGENT", "PREFIXES", "TIMEZONE", "LOG_LEVEL", "LOG_RETENTION_DAYS",
+	"CRON_SIX_REMINDER", "CRON_SIX_UPDATE", "CRON_SIX_DAILY", "CRON_SIX_WEEKLY",
+	"DOWNLOAD_MAX_MB", "HANDLER_TIMEOUT", "FEATURE_DEFAULTS",
+	"ADMIN_HTTP_ADDR", "ADMIN_HTTP_TOKEN",
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		file  string
+		env   map[string]string
+		check func(t *testing.T, conf *Config)
+		// Every variable expected in the error, nil when loading succeeds
+		errs []string
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{"DATABASE_URL": "postgres://env"},
+			check: func(t *testing.T, conf *Config) {
+				if !slices.Equal(conf.Prefixes, defaultPrefixes) {
+					t.Errorf("expected default prefixes, got %q", conf.Prefixes)
+				}
+				if conf.LogLevel != logger.LogLevelDebug {
+					t.Errorf("expected debug log level, got %s", conf.LogLevel)
+				}
+				if conf.HandlerTimeout != defaultHandlerTimeout {
+					t.Errorf("expected default handler timeout, got %s", conf.HandlerTimeout)
+				}
+				if conf.Timezone.String() != defaultTimezone {
+					t.Errorf("expected default timezone, got %s", conf.Timezone)
+				}
+			},
+		},
+		{
+			name: "env_over_file",
+			file: "database_url: postgres://file\nuser_agent: file-agent\nprefixes: [\"#\"]\nlog:\n  level: error\n",
+			env:  map[string]string{"DATABASE_URL": "postgres://env", "LOG_LEVEL": "warn"},
+			check: func(t *testing.T, conf *Config) {
+				if conf.DatabaseURL != "postgres://env" {
+					t.Errorf("expected the env database url, got %q", conf.DatabaseURL)
+				}
+				if conf.UserAgent != "file-agent" {
+					t.Errorf("expected the file user agent, got %q", conf.UserAgent)
+				}
+				if !slices.Equal(conf.Prefixes, []string{"#"}) {
+					t.Errorf("expected the file prefixes, got %q", conf.Prefixes)
+				}
+				if conf.LogLevel != logger.LogLevelWarn {
+					t.Errorf("expected the env log level, got %s", conf.LogLevel)
+				}
+			},
+		},
+		{
+			name: "empty_env_keeps_file",
+			file: "database_url: postgres://file\n",
+			env:  map[string]string{"DATABASE_URL": ""},
+			check: func(t *testing.T, conf *Config) {
+				if conf.DatabaseURL != "postgres://file" {
+					t.Errorf("expected the file database url, got %q", conf.DatabaseURL)
+				}
+			},
+		},
+		{
+			name: "prefixes",
+			file: "database_url: postgres://file\nprefixes: [\"#\"]\n",
+			env:  map[string]string{"PREFIXES": " ! , .,, kano "},
+			check: func(t *testing.T, conf *Config) {
+				if expected := []string{"!", ".", "kano"}; !slices.Equal(conf.Prefixes, expected) {
+					t.Errorf("expected prefixes %q, got %q", expected, conf.Prefixes)
+				}
+			},
+		},
+		{
+			name: "log_level",
+			env:  map[string]string{"DATABASE_URL": "postgres://env", "LOG_LEVEL": "info, Kano/Message=error"},
+			check: func(t *testing.T, conf *Config) {
+				if conf.LogLevel != logger.LogLevelInfo {
+					t.Errorf("expected info log level, got %s", conf.LogLevel)
+				}
+				if conf.LogLevels["Kano/Message"] != logger.LogLevelError {
+					t.Errorf("expected error level for Kano/Message, got %v", conf.LogLevels)
+				}
+			},
+		},
+		{
+			name: "feature_defaults",
+			file: "database_url: postgres://file\nfeatures:\n  download: false\n",
+			env:  map[string]string{"FEATURE_DEFAULTS": "game=off, Confess=on"},
+			check: func(t *testing.T, conf *Config) {
+				expected := map[string]bool{"game": false, "confess": true}
+				if !maps.Equal(conf.FeatureDefaults, expected) {
+					t.Errorf("expected feature defaults %v, got %v", expected, conf.FeatureDefaults)
+				}
+			},
+		},
+		{
+			name: "feature_defaults_from_file",
+			file: "database_url: postgres://file\nfeatures:\n  download: false\n",
+			check: func(t *testing.T, conf *Config) {
+				if enabled, ok := conf.FeatureDefaults["download"]; !ok || enabled {
+					t.Errorf("expected download off, got %v", conf.FeatureDefaults)
+				}
+			},
+		},
+		{
+			name: "bad_values",
+			env:  map[string]string{"DATABASE_URL": "postgres://env", "PREFIXES": " , ", "LOG_LEVEL": "loud", "FEATURE_DEFAULTS": "game"},
+			errs: []string{"PREFIXES", "LOG_LEVEL", "FEATURE_DEFAULTS"},
+		},
+		{
+			name: "errors_together",
+			file: "owner_only: maybe\n",
+			env:  map[string]string{"HANDLER_TIMEOUT": "-1s", "CRON_SIX_DAILY": "whenever", "PDDIKTI_KEY": "a2V5"},
+			errs: []string{"DATABASE_URL", "OWNER_ONLY", "HANDLER_TIMEOUT", "CRON_SIX_DAILY", "PDDIKTI_KEY", "config file"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range configEnv {
+				t.Setenv(env, "")
+			}
+			for env, value := range tt.env {
+				t.Setenv(env, value)
+			}
+
+			// Without CONFIG_FILE a config.yaml in the working directory is read
+			dir := t.TempDir()
+			t.Chdir(dir)
+			t.Setenv("CONFIG_FILE", "")
+			if tt.file != "" {
+				path := filepath.Join(dir, "config.yaml")
+				if err := os.WriteFile(path, []byte(tt.file), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				t.Setenv("CONFIG_FILE", path)
+			}
+
+			conf, err := LoadConfig()
+			if tt.errs == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				tt.check(t, conf)
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected errors for %v", tt.errs)
+			}
+			for _, expected := range tt.errs {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected %s in the error, got:\n%s", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadConfigUnknownFileKey(t *testing.T) {
+	for _, env := range configEnv {
+		t.Setenv(env, "")
+	}
+	path := filepath.Join(t.TempDir(), "kano.yaml")
+	if err := os.WriteFile(path, []byte("database_url: postgres://file\nprefix: [\"#\"]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_FILE", path)
+
+	if _, err := LoadConfig(); err == nil || !strings.Contains(err.Error(), "prefix") {
+		t.Errorf("expected an error for the unknown key, got %v", err)
+	}
+}
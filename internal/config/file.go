@@ -0,0 +1,82 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultConfigFile = "config.yaml"
+
+// Optional config file, every value can be overridden by its environment
+// variable written next to it
+type fileConfig struct {
+	DatabaseURL string `yaml:"database_url"` // DATABASE_URL
+	OwnerJID    string `yaml:"owner_jid"`    // OWNER_JID
+	OwnerOnly   string `yaml:"owner_only"`   // OWNER_ONLY
+	PddiktiKey  string `yaml:"pddikti_key"`  // PDDIKTI_KEY
+	PddiktiIv   string `yaml:"pddikti_iv"`   // PDDIKTI_IV
+	UserAgent   string `yaml:"user_agent"`   // USER_AGENT
+	// Comma separated in the environment
+	Prefixes []string `yaml:"prefixes"` // PREFIXES
+	Timezone string   `yaml:"timezone"` // TIMEZONE
+
+	HandlerTimeout string `yaml:"handler_timeout"` // HANDLER_TIMEOUT
+
+	Log struct {
+		Level         string `yaml:"level"`          // LOG_LEVEL
+		RetentionDays string `yaml:"retention_days"` // LOG_RETENTION_DAYS
+	} `yaml:"log"`
+
+	Cron struct {
+		SixReminder string `yaml:"six_reminder"` // CRON_SIX_REMINDER
+		SixUpdate   string `yaml:"six_update"`   // CRON_SIX_UPDATE
+		SixDaily    string `yaml:"six_daily"`    // CRON_SIX_DAILY
+		SixWeekly   string `yaml:"six_weekly"`   // CRON_SIX_WEEKLY
+	} `yaml:"cron"`
+
+	Download struct {
+		MaxMB string `yaml:"max_mb"` // DOWNLOAD_MAX_MB
+	} `yaml:"download"`
+
+	// key=on,key=off in the environment
+	Features map[string]bool `yaml:"features"` // FEATURE_DEFAULTS
+
+	AdminHTTP struct {
+		Addr  string `yaml:"addr"`  // ADMIN_HTTP_ADDR
+		Token string `yaml:"token"` // ADMIN_HTTP_TOKEN
+	} `yaml:"admin_http"`
+}
+
+// Reads CONFIG_FILE, or config.yaml if it exists. Unknown keys are errors so
+// typos don't go unnoticed.
+func readConfigFile() (fileConfig, string, error) {
+	var file fileConfig
+
+	// .env.example leaves it empty, which means unset
+	path := os.Getenv("CONFIG_FILE")
+	explicit := path != ""
+	if !explicit {
+		path = defaultConfigFile
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return file, "", nil
+		}
+		return file, path, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return file, path, fmt.Errorf("%s: %w", path, err)
+	}
+	return file, path, nil
+}
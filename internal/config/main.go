@@ -3,33 +3,30 @@ package config
 import (
 	"kano/internal/logger"
 	"kano/internal/utils/parser"
-	"time"
+	"sync/atomic"
 )
 
-const USER_AGENT = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/137.0.0.0 Safari/537.36"
-const logLevel = logger.LogLevelDebug
+const logDir = "logs"
 
 var log *logger.Logger
-var parserObj *parser.Parser
-var configObj *Config
-var Jakarta *time.Location
+var parserObj atomic.Pointer[parser.Parser]
+var configObj atomic.Pointer[Config]
 
 func Init() {
-	if log == nil {
-		log = logger.Init("Kano", logLevel)
-	}
-	if parserObj == nil {
-		parserObj = parser.Init([]string{"/", "!", "."})
+	if configObj.Load() == nil {
+		apply(InitConfig())
 	}
-	if configObj == nil {
-		configObj = InitConfig()
+	if log == nil {
+		conf := configObj.Load()
+		log = logger.Init("Kano", logger.Options{Dir: logDir, RetentionDays: conf.LogRetentionDays})
 	}
+}
 
-	var err error
-	Jakarta, err = time.LoadLocation("Asia/Jakarta")
-	if err != nil {
-		panic(err)
-	}
+// Makes conf the current config and updates what is derived from it
+func apply(conf *Config) {
+	configObj.Store(conf)
+	logger.SetLevels(conf.LogLevel, conf.LogLevels)
+	parserObj.Store(parser.Init(conf.Prefixes))
 }
 
 func GetLogger() *logger.Logger {
@@ -40,15 +37,15 @@ func GetLogger() *logger.Logger {
 }
 
 func GetParser() *parser.Parser {
-	if parserObj == nil {
+	if parserObj.Load() == nil {
 		Init()
 	}
-	return parserObj
+	return parserObj.Load()
 }
 
 func GetConfig() *Config {
-	if configObj == nil {
+	if configObj.Load() == nil {
 		Init()
 	}
-	return configObj
+	return configObj.Load()
 }
@@ -0,0 +1,104 @@
+package cronjobs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/netresearch/go-cron"
+)
+
+type JobStatus struct {
+	Name string    `json:"name"`
+	Spec string    `json:"spec"`
+	Next time.Time `json:"next"`
+	Prev time.Time `json:"prev,omitzero"`
+
+	Runs         uint64        `json:"runs"`
+	Running      bool          `json:"running"`
+	LastDuration time.Duration `json:"last_duration_ns"`
+}
+
+type jobRuns struct {
+	spec         string
+	runs         uint64
+	running      bool
+	lastDuration time.Duration
+}
+
+var (
+	scheduler *cron.Cron
+	jobs      = map[string]*jobRuns{}
+	jobsMu    sync.Mutex
+)
+
+// Adds a named job to the scheduler and keeps track of its runs for Status.
+// Every run gets ctx, which is cancelled when the bot stops.
+func Schedule(ctx context.Context, c *cron.Cron, name, spec string, job func(ctx context.Context)) error {
+	jobsMu.Lock()
+	scheduler = c
+	runs := &jobRuns{spec: spec}
+	jobs[name] = runs
+	jobsMu.Unlock()
+
+	_, err := c.AddFunc(spec, func() {
+		start := time.Now()
+		jobsMu.Lock()
+		runs.running = true
+		jobsMu.Unlock()
+
+		defer func() {
+			jobsMu.Lock()
+			runs.running = false
+			runs.runs++
+			runs.lastDuration = time.Since(start)
+			jobsMu.Unlock()
+		}()
+
+		job(ctx)
+	}, cron.WithName(name))
+	if err != nil {
+		jobsMu.Lock()
+		delete(jobs, name)
+		jobsMu.Unlock()
+	}
+	return err
+}
+
+// Status of every job added with Schedule, sorted by name
+func Status() []JobStatus {
+	jobsMu.Lock()
+	c := scheduler
+	jobsMu.Unlock()
+	if c == nil {
+		return nil
+	}
+
+	// Entries talks to the scheduler goroutine, don't hold the lock there
+	entries := c.Entries()
+
+	jobsMu.Lock()
+	defer jobsMu.Unlock()
+
+	res := []JobStatus{}
+	for _, entry := range entries {
+		runs, ok := jobs[entry.Name]
+		if !ok {
+			continue
+		}
+		res = append(res, JobStatus{
+			Name:         entry.Name,
+			Spec:         runs.spec,
+			Next:         entry.Next,
+			Prev:         entry.Prev,
+			Runs:         runs.runs,
+			Running:      runs.running,
+			LastDuration: runs.lastDuration,
+		})
+	}
+	slices.SortFunc(res, func(a, b JobStatus) int { return strings.Compare(a.Name, b.Name) })
+
+	return res
+}
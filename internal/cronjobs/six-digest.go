@@ -0,0 +1,133 @@
+package cronjobs
+
+import (
+	"context"
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/database"
+	"kano/internal/database/models"
+	"kano/internal/utils/chatutil/contactutil"
+	"kano/internal/utils/datetime"
+	"kano/internal/utils/i18n"
+	"kano/internal/utils/six/schedules"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+var digestLog = config.GetLogger().Sub("SixDigest")
+
+// Today's classes for everyone subscribed to the daily digest
+func SixDailyDigest(cli *whatsmeow.Client) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		loc := config.GetConfig().Timezone
+		now := time.Now().In(loc)
+		from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+		sendDigest(ctx, cli, "daily", from, from.AddDate(0, 0, 1), func(lang i18n.Lang, entries []models.ClassSchedule) string {
+			var builder strings.Builder
+			builder.WriteString(i18n.T(lang, "six.digest.today", schedules.RenderDate(lang, from, loc)))
+			for _, entry := range entries {
+				fmt.Fprintf(&builder, "\n- %s", schedules.RenderTimetableEntry(lang, entry, loc))
+			}
+			return builder.String()
+		})
+	}
+}
+
+// The coming week's classes, grouped by day, for everyone subscribed to the
+// weekly digest. Meant to run on Sunday, any other day still gets next week.
+func SixWeeklyDigest(cli *whatsmeow.Client) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		loc := config.GetConfig().Timezone
+		now := time.Now().In(loc)
+		from := datetime.NextMonday(now)
+		to := from.AddDate(0, 0, 7)
+
+		sendDigest(ctx, cli, "weekly", from, to, func(lang i18n.Lang, entries []models.ClassSchedule) string {
+			var builder strings.Builder
+			builder.WriteString(i18n.T(lang, "six.digest.next_week", schedules.RenderDate(lang, from, loc), schedules.RenderDate(lang, to.AddDate(0, 0, -1), loc)))
+
+			lastDay := -1
+			for _, entry := range entries {
+				if day := entry.Start.In(loc).YearDay(); day != lastDay {
+					fmt.Fprintf(&builder, "\n\n*%s*", schedules.RenderDate(lang, entry.Start, loc))
+					lastDay = day
+				}
+				fmt.Fprintf(&builder, "\n- %s", schedules.RenderTimetableEntry(lang, entry, loc))
+			}
+			return builder.String()
+		})
+	}
+}
+
+// Sends the followed classes starting in [from, to) to every subscriber of
+// the given digest column. Subscribers without classes in range get nothing.
+func sendDigest(ctx context.Context, cli *whatsmeow.Client, column string, from, to time.Time, render func(i18n.Lang, []models.ClassSchedule) string) {
+	db := database.GetInstance().WithContext(ctx)
+
+	var subscribers []types.JID
+	tx := db.Model(&models.ScheduleDigest{}).Where(column+" = ?", true).Pluck("jid", &subscribers)
+	if tx.Error != nil {
+		digestLog.Errorf("%s: failed to get subscribers: %s", column, tx.Error)
+		return
+	}
+	if len(subscribers) == 0 {
+		return
+	}
+
+	var follows []models.ClassFollower
+	tx = db.Select("jid", "subject_class_id").Where("jid IN ?", subscribers).Find(&follows)
+	if tx.Error != nil {
+		digestLog.Errorf("%s: failed to get followed classes: %s", column, tx.Error)
+		return
+	}
+
+	classIds := []uint{}
+	followers := map[uint][]types.JID{}
+	for _, f := range follows {
+		if _, ok := followers[f.SubjectClassID]; !ok {
+			classIds = append(classIds, f.SubjectClassID)
+		}
+		followers[f.SubjectClassID] = append(followers[f.SubjectClassID], f.Jid)
+	}
+	if len(classIds) == 0 {
+		return
+	}
+
+	var entries []models.ClassSchedule
+	tx = db.
+		Preload("Rooms").
+		Preload("SubjectClass.Subject").
+		Where("subject_class_id IN ? AND start >= ? AND start < ?", classIds, from, to).
+		Order("start").
+		Find(&entries)
+	if tx.Error != nil {
+		digestLog.Errorf("%s: failed to get schedules: %s", column, tx.Error)
+		return
+	}
+
+	perJid := map[types.JID][]models.ClassSchedule{}
+	for _, entry := range entries {
+		for _, jid := range followers[entry.SubjectClassID] {
+			perJid[jid] = append(perJid[jid], entry)
+		}
+	}
+
+	langs, err := contactutil.Langs(ctx, subscribers)
+	if err != nil {
+		digestLog.Errorf("%s: failed to get subscriber languages: %s", column, err)
+		return
+	}
+
+	for jid, entries := range perJid {
+		_, err := cli.SendMessage(ctx, jid, &waE2E.Message{Conversation: proto.String(render(langs[jid], entries))})
+		if err != nil {
+			digestLog.Errorf("%s: failed to send to %s: %s", column, jid, err)
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"kano/internal/config"
 	"kano/internal/database"
 	"kano/internal/database/models"
+	"kano/internal/utils/chatutil/contactutil"
+	"kano/internal/utils/i18n"
 	"math"
 	"strings"
 	"time"
@@ -20,19 +22,25 @@ import (
 
 var errorSent = false
 
-func SixReminder(cli *whatsmeow.Client) func() {
-	return func() {
-		send := func(target types.JID, msg string) {
-			cli.SendMessage(context.Background(), target, &waE2E.Message{Conversation: proto.String(msg)})
+var reminderLog = config.GetLogger().Sub("SixReminder")
+
+func SixReminder(cli *whatsmeow.Client) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		send := func(target types.JID, msg string) (types.MessageID, error) {
+			resp, err := cli.SendMessage(ctx, target, &waE2E.Message{Conversation: proto.String(msg)})
+			return resp.ID, err
 		}
-		owner := config.GetConfig().OwnerJID
+		conf := config.GetConfig()
+		owner := conf.OwnerJID
 
-		now := time.Now().In(config.Jakarta)
-		// now := time.Unix(1770631200, 0).In(config.Jakarta)
-		dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, config.Jakarta)
+		now := time.Now().In(conf.Timezone)
+		// now := time.Unix(1770631200, 0).In(conf.Timezone)
+		dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, conf.Timezone)
 
 		db := database.GetInstance()
 
+		sendSnoozes(ctx, db, now, send)
+
 		res, err := gorm.G[models.ClassReminderView](db).
 			Joins(clause.LeftJoin.Association("Delivery"), models.NoopJoin).
 			Joins(clause.LeftJoin.Association("Schedule"), models.NoopJoin).
@@ -42,14 +50,19 @@ func SixReminder(cli *whatsmeow.Client) func() {
 				"alert_time_unix >= ? AND alert_time_unix <= ? AND \"Delivery\".schedule_id IS NULL",
 				dayStart.Unix(), now.Unix(),
 			).
+			// Muted or paused by the user, these are never sent later
+			Where(
+				"NOT EXISTS (SELECT 1 FROM reminder_setting rs WHERE rs.jid = class_reminder_view.jid AND " +
+					"(rs.muted OR rs.paused_until > to_timestamp(class_reminder_view.alert_time_unix)))",
+			).
 			Order("alert_time_unix").
-			Find(context.Background())
+			Find(ctx)
 		if err != nil {
 			if !errorSent {
-				send(owner, fmt.Sprintf("SixReminder: Gagal mengambil data reminder: %s", err))
+				send(owner, i18n.T(i18n.Default, "six.reminder.fetch_failed", err))
 				errorSent = true
 			} else {
-				fmt.Println(err)
+				reminderLog.Errorf("Failed to get reminders: %s", err)
 			}
 
 			return
@@ -60,6 +73,16 @@ func SixReminder(cli *whatsmeow.Client) func() {
 			return
 		}
 
+		recipients := make([]types.JID, 0, len(res))
+		for _, remView := range res {
+			recipients = append(recipients, remView.Jid)
+		}
+		// Without the preferences everyone just gets the default language
+		langs, err := contactutil.Langs(ctx, recipients)
+		if err != nil {
+			reminderLog.Errorf("Failed to get recipient languages: %s", err)
+		}
+
 		jids := map[types.JID]*strings.Builder{}
 		toInsert := make([]models.ClassReminderDelivery, 0, len(res))
 
@@ -71,6 +94,7 @@ func SixReminder(cli *whatsmeow.Client) func() {
 			})
 
 			jid := remView.Jid
+			lang := langs[jid]
 			if _, ok := jids[jid]; !ok {
 				jids[jid] = &strings.Builder{}
 			} else {
@@ -82,17 +106,17 @@ func SixReminder(cli *whatsmeow.Client) func() {
 
 			diff := alertTime.Sub(schedTime)
 
-			kapan := ""
+			var kapan i18n.MessageID
 			if diff < 0 {
-				kapan = "sebelum"
+				kapan = "six.reminder.before"
 			} else {
-				kapan = "setelah"
+				kapan = "six.reminder.after"
 			}
-			ref := ""
+			var ref i18n.MessageID
 			if remView.AnchorAtEnd {
-				ref = "berakhir"
+				ref = "six.reminder.ends"
 			} else {
-				ref = "dimulai"
+				ref = "six.reminder.starts"
 			}
 
 			diff = diff.Abs()
@@ -100,34 +124,34 @@ func SixReminder(cli *whatsmeow.Client) func() {
 			jam := int(math.Floor(diff.Hours())) % 24
 			menit := int(math.Floor(diff.Minutes())) % 60
 
-			fmt.Fprintf(jids[jid], "Reminder: ")
-
+			var offset strings.Builder
 			if hari > 0 {
-				fmt.Fprintf(jids[jid], "%d hari ", hari)
+				offset.WriteString(i18n.T(lang, "six.reminder.days", hari))
 			}
 			if jam > 0 {
-				fmt.Fprintf(jids[jid], "%d jam ", jam)
+				offset.WriteString(i18n.T(lang, "six.reminder.hours", jam))
 			}
 			if menit > 0 {
-				fmt.Fprintf(jids[jid], "%d menit ", menit)
+				offset.WriteString(i18n.T(lang, "six.reminder.minutes", menit))
 			}
 
-			fmt.Fprintf(jids[jid], "%s kelas %s-%02d (%s) %s",
-				kapan,
+			jids[jid].WriteString(i18n.T(lang, "six.reminder.alert",
+				offset.String(),
+				i18n.T(lang, kapan),
 				remView.SubjectClass.Subject.Code,
 				remView.SubjectClass.Number,
 				remView.SubjectClass.Subject.Name,
-				ref,
-			)
+				i18n.T(lang, ref),
+			))
 		}
 
-		tx := db.WithContext(context.Background()).CreateInBatches(&toInsert, 1000)
+		tx := db.WithContext(ctx).CreateInBatches(&toInsert, 1000)
 		if tx.Error != nil {
 			if !errorSent {
-				send(owner, fmt.Sprintf("SixReminder: Gagal menyimpan hasil delivery: %s", err))
+				send(owner, i18n.T(i18n.Default, "six.reminder.delivery_failed", tx.Error))
 				errorSent = true
 			} else {
-				fmt.Println(err)
+				reminderLog.Errorf("Failed to save deliveries: %s", tx.Error)
 			}
 
 			return // Avoid to continue sending updates to users
@@ -135,7 +159,41 @@ func SixReminder(cli *whatsmeow.Client) func() {
 		errorSent = false
 
 		for jid, builder := range jids {
-			send(jid, builder.String())
+			content := builder.String()
+			if id, err := send(jid, content); err == nil {
+				rememberReminder(ctx, db, jid, id, content)
+			}
+		}
+	}
+}
+
+// How long a sent reminder can still be snoozed
+const snoozableFor = 24 * time.Hour
+
+// Keeps the sent reminder so reacting to it can snooze it
+func rememberReminder(ctx context.Context, db *gorm.DB, jid types.JID, id types.MessageID, content string) {
+	tx := db.WithContext(ctx).Create(&models.ClassReminderMessage{MessageId: id, Jid: jid, Content: content})
+	if tx.Error != nil {
+		reminderLog.Errorf("Failed to save reminder message: %s", tx.Error)
+	}
+}
+
+// Sends again the reminders snoozed with a reaction. They are removed first,
+// a failed send is not retried.
+func sendSnoozes(ctx context.Context, db *gorm.DB, now time.Time, send func(types.JID, string) (types.MessageID, error)) {
+	db = db.WithContext(ctx)
+	db.Where("sent_at < ?", now.Add(-snoozableFor)).Delete(&models.ClassReminderMessage{})
+
+	var due []models.ClassReminderSnooze
+	tx := db.Clauses(clause.Returning{}).Where("due_at_unix <= ?", now.Unix()).Delete(&due)
+	if tx.Error != nil {
+		reminderLog.Errorf("Failed to get snoozed reminders: %s", tx.Error)
+		return
+	}
+
+	for _, snooze := range due {
+		if id, err := send(snooze.Jid, snooze.Content); err == nil {
+			rememberReminder(ctx, db, snooze.Jid, id, snooze.Content)
 		}
 	}
 }
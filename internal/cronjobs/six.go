@@ -14,23 +14,25 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-func SixUpdateSchedules(cli *whatsmeow.Client) func() {
+func SixUpdateSchedules(cli *whatsmeow.Client) func(ctx context.Context) {
 	conf := config.GetConfig()
-	send := func(msg string) {
-		if conf.OwnerJID.User == "" {
-			fmt.Println(msg)
-			return
-		}
+	log := config.GetLogger().Sub("SixUpdateSchedules")
 
-		cli.SendMessage(context.Background(), conf.OwnerJID, &waE2E.Message{
-			Conversation: proto.String(msg),
-		})
-	}
+	return func(ctx context.Context) {
+		send := func(msg string) {
+			if conf.OwnerJID.User == "" {
+				log.Infof("%s", msg)
+				return
+			}
+
+			cli.SendMessage(ctx, conf.OwnerJID, &waE2E.Message{
+				Conversation: proto.String(msg),
+			})
+		}
 
-	return func() {
-		fmt.Println("Running SixUpdateSchedules")
+		log.Infof("Running schedule update")
 		send("Starting schedule update...")
-		subjects, err := six.GetAllSchedules()
+		subjects, err := six.GetAllSchedules(ctx)
 		if err != nil {
 			send(fmt.Sprintf("Failed to fetch schedules: %s", err))
 			return
@@ -42,7 +44,13 @@ func SixUpdateSchedules(cli *whatsmeow.Client) func() {
 			return
 		}
 
-		err = schedules.ApplyDiff(diff)
+		// Followers of removed classes are gone once the diff is applied
+		updates, err := schedules.FollowerUpdates(ctx, diff, config.GetConfig().Timezone)
+		if err != nil {
+			send(fmt.Sprintf("Failed to render follower updates: %s", err))
+		}
+
+		err = schedules.ApplyDiff(ctx, diff)
 		if err != nil {
 			send(fmt.Sprintf("Failed to apply diff: %s", err))
 			return
@@ -50,6 +58,16 @@ func SixUpdateSchedules(cli *whatsmeow.Client) func() {
 
 		schedules.CleanupTmpFiles()
 
+		notified := 0
+		for jid, msg := range updates {
+			_, err := cli.SendMessage(ctx, jid, &waE2E.Message{Conversation: proto.String(msg)})
+			if err != nil {
+				log.Errorf("Failed to notify %s: %s", jid, err)
+				continue
+			}
+			notified++
+		}
+
 		addedSubjects := 0
 		removedSubjects := 0
 		modifiedSubjects := 0
@@ -70,8 +88,8 @@ func SixUpdateSchedules(cli *whatsmeow.Client) func() {
 
 		send(
 			fmt.Sprintf(
-				"Schedules updated, with AddedSubjects=%d, RemovedSubjects=%d, ModifiedSubjects={%d, AddedClasses=%d, RemovedClasses=%d, ModifiedClasses=%d}",
-				addedSubjects, removedSubjects, modifiedSubjects, addedClasses, removedClasses, modifiedClasses,
+				"Schedules updated, with AddedSubjects=%d, RemovedSubjects=%d, ModifiedSubjects={%d, AddedClasses=%d, RemovedClasses=%d, ModifiedClasses=%d}, NotifiedFollowers=%d/%d",
+				addedSubjects, removedSubjects, modifiedSubjects, addedClasses, removedClasses, modifiedClasses, notified, len(updates),
 			),
 		)
 
@@ -80,13 +98,13 @@ func SixUpdateSchedules(cli *whatsmeow.Client) func() {
 			return
 		}
 
-		resp, err := cli.Upload(context.Background(), mar, whatsmeow.MediaDocument)
+		resp, err := cli.Upload(ctx, mar, whatsmeow.MediaDocument)
 		if err != nil {
 			return
 		}
 
 		now := time.Now()
-		cli.SendMessage(context.Background(), conf.OwnerJID, &waE2E.Message{
+		cli.SendMessage(ctx, conf.OwnerJID, &waE2E.Message{
 			DocumentMessage: &waE2E.DocumentMessage{
 				Mimetype:          proto.String("application/json"),
 				FileName:          proto.String(fmt.Sprintf("scheddiff_%4d-%02d-%02d_%02d.json", now.Year(), now.Month(), now.Day(), now.Hour())),
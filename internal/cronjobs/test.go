@@ -1,13 +1,14 @@
 package cronjobs
 
 import (
+	"context"
 	"fmt"
 
 	"go.mau.fi/whatsmeow"
 )
 
-func TestCronJob(_cli *whatsmeow.Client) func() {
-	return func() {
+func TestCronJob(_cli *whatsmeow.Client) func(ctx context.Context) {
+	return func(ctx context.Context) {
 		fmt.Println("TestCronJob called")
 	}
 }
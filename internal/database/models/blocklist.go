@@ -0,0 +1,23 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// A contact or group the bot ignores
+type Blocklist struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	JID    types.JID `gorm:"not null;type:text;column:jid"`
+	Reason string
+	// Null means forever
+	ExpiresAt sql.NullTime
+}
+
+func (_ Blocklist) TableName() string {
+	return "blocklist"
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+type CommandUsage struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	Command    string
+	Alias      string
+	Subcommand sql.NullString
+
+	GroupId   sql.NullInt32
+	ContactId sql.NullInt32
+
+	DurationMs int64
+	Outcome    string
+	Error      sql.NullString
+}
+
+func (_ CommandUsage) TableName() string {
+	return "command_usage"
+}
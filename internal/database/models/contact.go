@@ -3,6 +3,7 @@ package models
 import (
 	"database/sql"
 
+	"github.com/lib/pq"
 	"go.mau.fi/whatsmeow/types"
 	"gorm.io/gorm"
 )
@@ -17,6 +18,13 @@ type Contact struct {
 	ConfessTarget      sql.NullInt32
 	ConfessTargetGroup *Group `gorm:"foreignKey:ConfessTarget;references:ID"`
 
+	// Empty means the default prefixes
+	Prefixes                pq.StringArray `gorm:"type:text[]"`
+	IsMentionTriggerAllowed bool
+
+	// Empty means the default language
+	Language string
+
 	Participants []Participant
 }
 
@@ -2,7 +2,9 @@ package models
 
 import (
 	"database/sql"
+	"time"
 
+	"github.com/lib/pq"
 	"go.mau.fi/whatsmeow/types"
 	"gorm.io/gorm"
 )
@@ -25,9 +27,14 @@ func (_ Group) TableName() string {
 }
 
 type GroupSettings struct {
-	ID               uint `gorm:"primaryKey"`
-	IsGameAllowed    bool
-	IsConfessAllowed bool
+	ID uint `gorm:"primaryKey"`
+
+	// Empty means the default prefixes
+	Prefixes                pq.StringArray `gorm:"type:text[]"`
+	IsMentionTriggerAllowed bool
+
+	// Empty means the default language
+	Language string
 
 	Group *Group `gorm:"foreignKey:ID;references:ID"`
 }
@@ -35,3 +42,15 @@ type GroupSettings struct {
 func (_ GroupSettings) TableName() string {
 	return "group_settings"
 }
+
+// A feature flag value of a group, missing rows mean the feature default
+type GroupFeature struct {
+	GroupId   uint   `gorm:"primaryKey"`
+	Key       string `gorm:"primaryKey"`
+	Enabled   bool
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+func (_ GroupFeature) TableName() string {
+	return "group_feature"
+}
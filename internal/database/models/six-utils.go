@@ -1,6 +1,11 @@
 package models
 
-import "go.mau.fi/whatsmeow/types"
+import (
+	"database/sql"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
 
 type ClassReminder struct {
 	ID             uint      `gorm:"primaryKey;autoIncrement"`
@@ -48,9 +53,51 @@ type ClassFollower struct {
 	Jid            types.JID `gorm:"not null;type:text;uniqueIndex:classReminder_jid_subjectClassId_offset_unique"`
 	SubjectClassID uint      `gorm:"not null;uniqueIndex:classReminder_jid_subjectClassId_offset_unique"`
 
-	Subject Subject `gorm:"foreignKey:SubjectClassID;references:ID"`
+	SubjectClass *SubjectClass `gorm:"foreignKey:SubjectClassID;references:ID"`
 }
 
 func (_ ClassFollower) TableName() string {
 	return "class_follower"
 }
+
+type ReminderSetting struct {
+	Jid         types.JID `gorm:"primaryKey;type:text"`
+	Muted       bool      `gorm:"not null;default:false"`
+	PausedUntil sql.NullTime
+}
+
+func (_ ReminderSetting) TableName() string {
+	return "reminder_setting"
+}
+
+type ClassReminderMessage struct {
+	MessageId string    `gorm:"primaryKey"`
+	Jid       types.JID `gorm:"not null;type:text"`
+	Content   string    `gorm:"not null"`
+	SentAt    time.Time `gorm:"autoCreateTime"`
+}
+
+func (_ ClassReminderMessage) TableName() string {
+	return "class_reminder_message"
+}
+
+type ClassReminderSnooze struct {
+	MessageId string    `gorm:"primaryKey"`
+	Jid       types.JID `gorm:"not null;type:text"`
+	Content   string    `gorm:"not null"`
+	DueAtUnix int64     `gorm:"not null"`
+}
+
+func (_ ClassReminderSnooze) TableName() string {
+	return "class_reminder_snooze"
+}
+
+type ScheduleDigest struct {
+	Jid    types.JID `gorm:"primaryKey;type:text"`
+	Daily  bool      `gorm:"not null;default:false"`
+	Weekly bool      `gorm:"not null;default:false"`
+}
+
+func (_ ScheduleDigest) TableName() string {
+	return "schedule_digest"
+}
@@ -8,22 +8,20 @@ import (
 	"go.mau.fi/whatsmeow/types"
 )
 
-var connectedCount = 0
-
-func Connected(cli *whatsmeow.Client) error {
-	connectedCount++
+func Connected(ctx context.Context, cli *whatsmeow.Client) error {
+	setConnected(true)
 
 	logger := config.GetLogger().Sub("Connected")
 
 	// logger.Debugf("Setting \"Force Active Delivery Receipts\" to true")
 	// cli.SetForceActiveDeliveryReceipts(true)
 	logger.Debugf("Marking client presence as online")
-	err := cli.SendPresence(context.Background(), types.PresenceAvailable)
+	err := cli.SendPresence(ctx, types.PresenceAvailable)
 	if err != nil {
 		logger.Warnf("Failed to set client presence as online: %s", err.Error())
 	}
 
-	logger.Infof("Connected #%d", connectedCount)
+	logger.Infof("Connected #%d", Status().Connects)
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 )
 
 func Disconnected() error {
+	setConnected(false)
 
 	logger := config.GetLogger().Sub("Disconnected")
 	logger.Warnf("Disconnected from server, reconnecting")
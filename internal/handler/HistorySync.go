@@ -15,7 +15,7 @@ import (
 	"gorm.io/gorm/clause"
 )
 
-func pushnames(l *logger.Logger, cli *whatsmeow.Client, pushes []*waHistorySync.Pushname) error {
+func pushnames(ctx context.Context, l *logger.Logger, cli *whatsmeow.Client, pushes []*waHistorySync.Pushname) error {
 	pushnames := map[types.JID]string{}
 	pns := []types.JID{}
 
@@ -52,7 +52,7 @@ func pushnames(l *logger.Logger, cli *whatsmeow.Client, pushes []*waHistorySync.
 		pushnames[jid] = name
 	}
 
-	lids, err := cli.Store.LIDs.GetManyLIDsForPNs(context.Background(), pns)
+	lids, err := cli.Store.LIDs.GetManyLIDsForPNs(ctx, pns)
 	if err != nil {
 		l.Errorf("Failed to get lids")
 		return err
@@ -77,7 +77,7 @@ func pushnames(l *logger.Logger, cli *whatsmeow.Client, pushes []*waHistorySync.
 		i++
 	}
 
-	db := database.GetInstance()
+	db := database.GetInstance().WithContext(ctx)
 	tx := db.Clauses(clause.OnConflict{
 		OnConstraint: "contact_jid_unique",
 		DoUpdates:    clause.AssignmentColumns([]string{"push_name"}),
@@ -91,7 +91,7 @@ func pushnames(l *logger.Logger, cli *whatsmeow.Client, pushes []*waHistorySync.
 	return nil
 }
 
-func conversations(l *logger.Logger, cli *whatsmeow.Client, convs []*waHistorySync.Conversation) error {
+func conversations(ctx context.Context, l *logger.Logger, cli *whatsmeow.Client, convs []*waHistorySync.Conversation) error {
 	for i, conv := range convs {
 		if conv == nil {
 			l.Warnf("Conv at idx %d is nil, skipping", i)
@@ -111,7 +111,7 @@ func conversations(l *logger.Logger, cli *whatsmeow.Client, convs []*waHistorySy
 			continue
 		}
 
-		grp, err := grouputil.InitDb(cli, jid)
+		grp, err := grouputil.InitDb(ctx, cli, jid)
 		if err != nil {
 			l.Errorf("Failed to init group: %s", err.Error())
 			return err
@@ -122,13 +122,13 @@ func conversations(l *logger.Logger, cli *whatsmeow.Client, convs []*waHistorySy
 	return nil
 }
 
-func HistorySync(cli *whatsmeow.Client, ev *events.HistorySync) (err error) {
+func HistorySync(ctx context.Context, cli *whatsmeow.Client, ev *events.HistorySync) (err error) {
 	l := config.GetLogger().Sub("HistorySync")
 	l.Debugf("Got sync type: %s", ev.Data.GetSyncType().String())
 
 	if p := ev.Data.GetPushnames(); len(p) > 0 {
 		l.Debugf("Found %d pushname data(s), processing", len(p))
-		err = pushnames(l.Sub("Pushname"), cli, p)
+		err = pushnames(ctx, l.Sub("Pushname"), cli, p)
 		if err != nil {
 			l.Errorf("%s", err.Error())
 		}
@@ -136,7 +136,7 @@ func HistorySync(cli *whatsmeow.Client, ev *events.HistorySync) (err error) {
 
 	if c := ev.Data.GetConversations(); len(c) > 0 {
 		l.Debugf("Found %d conversation(s), processing", len(c))
-		err = conversations(l.Sub("Conversation"), cli, c)
+		err = conversations(ctx, l.Sub("Conversation"), cli, c)
 		if err != nil {
 			l.Errorf("%s", err.Error())
 		}
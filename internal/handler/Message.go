@@ -4,6 +4,7 @@ import (
 	"context"
 	"kano/internal/config"
 	"kano/internal/message"
+	"kano/internal/utils/messageutil"
 	"time"
 
 	"go.mau.fi/whatsmeow"
@@ -11,7 +12,10 @@ import (
 	"go.mau.fi/whatsmeow/types/events"
 )
 
-func Message(cli *whatsmeow.Client, evt *events.Message) error {
+// Busy's reaction is sent outside the worker pool, so it gets its own deadline
+const busyTimeout = 10 * time.Second
+
+func Message(ctx context.Context, cli *whatsmeow.Client, evt *events.Message) error {
 	log := config.GetLogger().Sub("Message")
 
 	// Mark as read
@@ -20,10 +24,27 @@ func Message(cli *whatsmeow.Client, evt *events.Message) error {
 	chat := evt.Info.Chat
 
 	log.Debugf("Marking message %s as read at chat %s with sender %s", evt.Info.ID, chat.String(), sender.String())
-	err := cli.MarkRead(context.Background(), []types.MessageID{evt.Info.ID}, time.Now(), chat, sender)
+	err := cli.MarkRead(ctx, []types.MessageID{evt.Info.ID}, time.Now(), chat, sender)
 	if err != nil {
 		log.Warnf("Failed to mark message %s as read at chat %s: %s", evt.Info.ID, chat.String(), err.Error())
 	}
 
-	return message.Main(cli, evt)
+	return message.Main(ctx, cli, evt)
+}
+
+// Lets the sender know their message is dropped because the worker queue is full
+func Busy(cli *whatsmeow.Client, evt *events.Message) {
+	log := config.GetLogger().Sub("Message")
+	log.Warnf("Worker queue is full, dropping message %s at chat %s", evt.Info.ID, evt.Info.Chat.String())
+
+	// Called on the event goroutine, a slow send must not hold up other events
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), busyTimeout)
+		defer cancel()
+
+		_, err := cli.SendMessage(ctx, evt.Info.Chat, messageutil.BuildReaction(evt.Info, "🚦"))
+		if err != nil {
+			log.Warnf("Failed to send busy reaction to message %s: %s", evt.Info.ID, err.Error())
+		}
+	}()
 }
@@ -10,7 +10,7 @@ import (
 	"go.mau.fi/whatsmeow/types/events"
 )
 
-func UndecryptableMessage(cli *whatsmeow.Client, ev *events.UndecryptableMessage) (err error) {
+func UndecryptableMessage(ctx context.Context, cli *whatsmeow.Client, ev *events.UndecryptableMessage) (err error) {
 	log := config.GetLogger().Sub("UndecryptableMessage")
 
 	if !ev.IsUnavailable {
@@ -21,7 +21,7 @@ func UndecryptableMessage(cli *whatsmeow.Client, ev *events.UndecryptableMessage
 	switch ev.UnavailableType {
 	case events.UnavailableTypeViewOnce:
 		log.Debugf("Message %s:%s is a view once message, marking as read", ev.Info.Chat.String(), ev.Info.ID)
-		err := cli.MarkRead(context.Background(), []types.MessageID{ev.Info.ID}, time.Now(), ev.Info.Chat, ev.Info.Sender)
+		err := cli.MarkRead(ctx, []types.MessageID{ev.Info.ID}, time.Now(), ev.Info.Chat, ev.Info.Sender)
 		if err != nil {
 			log.Warnf("Failed to mark message %s:%s as read: %s", ev.Info.Chat.String(), ev.Info.ID, err.Error())
 		}
@@ -1,30 +1,33 @@
 package handler
 
 import (
+	"context"
+
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/types/events"
 )
 
-func Handle(cli *whatsmeow.Client, evt any) error {
+// ctx bounds the whole handling of the event, including the command it runs
+func Handle(ctx context.Context, cli *whatsmeow.Client, evt any) error {
 	LogEvent(evt)
 
 	switch ev := evt.(type) {
 	case *events.ChatPresence:
 		return ChatPresence(ev)
 	case *events.Connected:
-		return Connected(cli)
+		return Connected(ctx, cli)
 	case *events.Disconnected:
 		return Disconnected()
 	case *events.GroupInfo:
 		return GroupInfo(cli, ev)
 	case *events.HistorySync:
-		return HistorySync(cli, ev)
+		return HistorySync(ctx, cli, ev)
 	case *events.KeepAliveTimeout:
 		return KeepAliveTimeout(ev)
 	case *events.LoggedOut:
 		return Login(cli)
 	case *events.Message:
-		return Message(cli, ev)
+		return Message(ctx, cli, ev)
 	case *events.OfflineSyncCompleted:
 		return OfflineSyncCompleted(ev)
 	case *events.OfflineSyncPreview:
@@ -38,7 +41,7 @@ func Handle(cli *whatsmeow.Client, evt any) error {
 	case *events.Receipt:
 		return Receipt(ev)
 	case *events.UndecryptableMessage:
-		return UndecryptableMessage(cli, ev)
+		return UndecryptableMessage(ctx, cli, ev)
 	case *events.UserAbout:
 		return UserAbout(ev)
 	default:
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"sync"
+	"time"
+)
+
+type ConnectionStatus struct {
+	Connected        bool
+	Connects         int
+	LastConnected    time.Time
+	LastDisconnected time.Time
+}
+
+var (
+	status   ConnectionStatus
+	statusMu sync.Mutex
+)
+
+// Connection state as seen through the Connected and Disconnected events
+func Status() ConnectionStatus {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	return status
+}
+
+func setConnected(connected bool) {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+
+	status.Connected = connected
+	if connected {
+		status.Connects++
+		status.LastConnected = time.Now()
+	} else {
+		status.LastDisconnected = time.Now()
+	}
+}
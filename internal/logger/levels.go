@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+)
+
+// Minimum levels, shared by every logger so they can change at runtime. A
+// subsystem level applies to its subs too, e.g. Kano/Message covers
+// Kano/Message/Stk.
+var (
+	defaultLevel    = LogLevelDebug
+	subsystemLevels = map[string]LogLevel{}
+	levelsMu        sync.RWMutex
+)
+
+func levelFor(name string) LogLevel {
+	levelsMu.RLock()
+	defer levelsMu.RUnlock()
+
+	for {
+		if level, ok := subsystemLevels[name]; ok {
+			return level
+		}
+		idx := strings.LastIndexByte(name, '/')
+		if idx == -1 {
+			return defaultLevel
+		}
+		name = name[:idx]
+	}
+}
+
+func ParseLevel(s string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return LogLevelDebug, nil
+	case "info":
+		return LogLevelInfo, nil
+	case "warn", "warning":
+		return LogLevelWarn, nil
+	case "error":
+		return LogLevelError, nil
+	default:
+		return 0, fmt.Errorf("unknown log level %q, use debug, info, warn or error", s)
+	}
+}
+
+// Parses "info,Kano/LogEvent=warn": the default level followed by
+// subsystem overrides, both optional
+func ParseLevels(s string) (LogLevel, map[string]LogLevel, error) {
+	def := LogLevelDebug
+	subs := map[string]LogLevel{}
+	for part := range strings.SplitSeq(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, value, isSub := strings.Cut(part, "=")
+		if !isSub {
+			value = name
+		}
+		level, err := ParseLevel(value)
+		if err != nil {
+			return def, nil, err
+		}
+		if isSub {
+			subs[strings.TrimSpace(name)] = level
+		} else {
+			def = level
+		}
+	}
+	return def, subs, nil
+}
+
+// Replaces every level
+func SetLevels(def LogLevel, subs map[string]LogLevel) {
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+
+	defaultLevel = def
+	subsystemLevels = maps.Clone(subs)
+	if subsystemLevels == nil {
+		subsystemLevels = map[string]LogLevel{}
+	}
+}
+
+func SetLevel(subsystem string, level LogLevel) {
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	subsystemLevels[subsystem] = level
+}
+
+// Makes the subsystem follow its parent again
+func ResetLevel(subsystem string) {
+	levelsMu.Lock()
+	defer levelsMu.Unlock()
+	delete(subsystemLevels, subsystem)
+}
+
+func Levels() (LogLevel, map[string]LogLevel) {
+	levelsMu.RLock()
+	defer levelsMu.RUnlock()
+	return defaultLevel, maps.Clone(subsystemLevels)
+}
@@ -0,0 +1,35 @@
+package logger
+
+import "testing"
+
+func TestLevelFor(t *testing.T) {
+	def, subs, err := ParseLevels("info, Kano/Message=debug ,Kano/Message/Stk=error")
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetLevels(def, subs)
+	defer SetLevels(LogLevelDebug, nil)
+
+	cases := map[string]LogLevel{
+		"Kano":                LogLevelInfo,
+		"Kano/Usage":          LogLevelInfo,
+		"Kano/Message":        LogLevelDebug,
+		"Kano/Message/Sawit":  LogLevelDebug,
+		"Kano/Message/Stk":    LogLevelError,
+		"Kano/Message/Stk/Go": LogLevelError,
+	}
+	for name, want := range cases {
+		if got := levelFor(name); got != want {
+			t.Errorf("levelFor(%q) = %s, want %s", name, got, want)
+		}
+	}
+
+	ResetLevel("Kano/Message/Stk")
+	if got := levelFor("Kano/Message/Stk"); got != LogLevelDebug {
+		t.Errorf("after reset expected the parent level debug, got %s", got)
+	}
+
+	if _, _, err := ParseLevels("info,Kano=loud"); err == nil {
+		t.Errorf("expected an error for an unknown level")
+	}
+}
@@ -1,8 +1,8 @@
 package logger
 
 import (
+	"encoding/json"
 	"fmt"
-	"os"
 	"time"
 )
 
@@ -15,33 +15,64 @@ const (
 	LogLevelError
 )
 
+var levelToString = map[LogLevel]string{
+	LogLevelDebug: "debug",
+	LogLevelError: "error",
+	LogLevelInfo:  "info",
+	LogLevelWarn:  "warn",
+}
+
+func (l LogLevel) String() string {
+	str, ok := levelToString[l]
+	if !ok {
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+	return str
+}
+
 type Logger struct {
-	file     *os.File
-	name     string
-	minLevel LogLevel
+	out  *sink
+	name string
+
+	// Optional context, only written when set
+	chat      string
+	messageID string
+	command   string
 }
 
-var levelToString = map[LogLevel]string{
-	LogLevelDebug: "DEBUG",
-	LogLevelError: "ERROR",
-	LogLevelInfo:  "INFO ",
-	LogLevelWarn:  "WARN ",
+// One JSON object per line
+type line struct {
+	Time      string `json:"time"`
+	Level     string `json:"level"`
+	Subsystem string `json:"subsystem"`
+	Chat      string `json:"chat,omitempty"`
+	MessageID string `json:"message_id,omitempty"`
+	Command   string `json:"command,omitempty"`
+	Msg       string `json:"msg"`
 }
 
 func (l *Logger) outputf(level LogLevel, msg string, args ...any) {
-	if level < l.minLevel {
+	if level < levelFor(l.name) {
 		return
 	}
-	str, ok := levelToString[level]
-	if !ok {
-		str = "UKWN"
-	}
-	logStr := fmt.Sprintf("%s [%s %s] %s\n", time.Now().Format("15:04:05.000"), str, l.name, fmt.Sprintf(msg, args...))
-	if l.file == nil {
-		fmt.Print(logStr)
-	} else {
-		l.file.WriteString(logStr)
+
+	now := time.Now()
+	formatted := fmt.Sprintf(msg, args...)
+	record(level, l.name, formatted)
+
+	data, err := json.Marshal(line{
+		Time:      now.Format("2006-01-02T15:04:05.000Z07:00"),
+		Level:     level.String(),
+		Subsystem: l.name,
+		Chat:      l.chat,
+		MessageID: l.messageID,
+		Command:   l.command,
+		Msg:       formatted,
+	})
+	if err != nil {
+		data = fmt.Appendf(nil, `{"level":"error","subsystem":%q,"msg":"unable to encode log line: %s"}`, l.name, err)
 	}
+	l.out.write(now, append(data, '\n'))
 }
 
 func (l *Logger) Debugf(msg string, args ...any) { l.outputf(LogLevelDebug, msg, args...) }
@@ -49,22 +80,36 @@ func (l *Logger) Infof(msg string, args ...any)  { l.outputf(LogLevelInfo, msg,
 func (l *Logger) Warnf(msg string, args ...any)  { l.outputf(LogLevelWarn, msg, args...) }
 func (l *Logger) Errorf(msg string, args ...any) { l.outputf(LogLevelError, msg, args...) }
 func (l *Logger) Sub(name string) *Logger {
-	return &Logger{file: l.file, name: fmt.Sprintf("%s/%s", l.name, name), minLevel: l.minLevel}
+	sub := *l
+	sub.name = fmt.Sprintf("%s/%s", l.name, name)
+	return &sub
+}
+func (l *Logger) WithChat(chat string) *Logger {
+	sub := *l
+	sub.chat = chat
+	return &sub
+}
+func (l *Logger) WithMessageID(id string) *Logger {
+	sub := *l
+	sub.messageID = id
+	return &sub
+}
+func (l *Logger) WithCommand(command string) *Logger {
+	sub := *l
+	sub.command = command
+	return &sub
 }
 func (l *Logger) Close() error {
-	if l.file != nil {
-		return l.file.Close()
-	}
-	return nil
+	return l.out.close()
 }
 
-func Init(name string, minLevel LogLevel) *Logger {
-	filename := fmt.Sprintf("logs/%d.log", time.Now().UnixMilli())
-	file, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
-	if err != nil {
-		fmt.Println("unable to init log file, using stdout instead")
-		file = nil
-	}
+type Options struct {
+	// Directory of the daily log files
+	Dir string
+	// Days of log files to keep, 0 keeps everything
+	RetentionDays int
+}
 
-	return &Logger{file: file, name: name, minLevel: minLevel}
+func Init(name string, opts Options) *Logger {
+	return &Logger{out: newSink(opts.Dir, opts.RetentionDays), name: name}
 }
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+const recentSize = 50
+
+type Entry struct {
+	Time    time.Time `json:"time"`
+	Level   string    `json:"level"`
+	Name    string    `json:"name"`
+	Message string    `json:"message"`
+}
+
+// Last warnings and errors of every logger, for the status server
+var (
+	recent      [recentSize]Entry
+	recentNext  int
+	recentFull  bool
+	levelCounts = map[LogLevel]uint64{}
+	recentMu    sync.Mutex
+)
+
+func record(level LogLevel, name, msg string) {
+	recentMu.Lock()
+	defer recentMu.Unlock()
+
+	levelCounts[level]++
+	if level < LogLevelWarn {
+		return
+	}
+
+	recent[recentNext] = Entry{Time: time.Now(), Level: level.String(), Name: name, Message: msg}
+	recentNext = (recentNext + 1) % recentSize
+	if recentNext == 0 {
+		recentFull = true
+	}
+}
+
+// Recent warnings and errors, newest first
+func Recent() []Entry {
+	recentMu.Lock()
+	defer recentMu.Unlock()
+
+	n := recentNext
+	if recentFull {
+		n = recentSize
+	}
+
+	res := make([]Entry, 0, n)
+	for i := 1; i <= n; i++ {
+		res = append(res, recent[(recentNext-i+recentSize)%recentSize])
+	}
+	return res
+}
+
+// How many lines were written per level since startup
+func LevelCounts() map[string]uint64 {
+	recentMu.Lock()
+	defer recentMu.Unlock()
+
+	res := make(map[string]uint64, len(levelCounts))
+	for level, n := range levelCounts {
+		res[level.String()] = n
+	}
+	return res
+}
@@ -0,0 +1,106 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Writes to <dir>/<date>.log, switching files when the date changes and
+// removing the ones older than the retention
+type sink struct {
+	mu        sync.Mutex
+	dir       string
+	retention int
+
+	day  string
+	file *os.File
+}
+
+func newSink(dir string, retention int) *sink {
+	return &sink{dir: dir, retention: retention}
+}
+
+func (s *sink) write(now time.Time, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if day := now.Format(time.DateOnly); day != s.day {
+		s.rotate(now, day)
+	}
+	if s.file == nil {
+		os.Stdout.Write(data)
+		return
+	}
+	s.file.Write(data)
+}
+
+// Falls back to stdout until the next day if the file can't be opened
+func (s *sink) rotate(now time.Time, day string) {
+	s.day = day
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+	}
+	if s.dir == "" {
+		return
+	}
+
+	filename := filepath.Join(s.dir, day+".log")
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		fmt.Printf("unable to open log file %s, using stdout instead: %s\n", filename, err)
+		return
+	}
+	s.file = file
+
+	s.prune(now)
+}
+
+func (s *sink) prune(now time.Time) {
+	if s.retention <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return
+	}
+
+	cutoff := now.AddDate(0, 0, -s.retention)
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".log")
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		// Date named files, or the old unix millisecond ones by their age
+		modified, err := time.ParseInLocation(time.DateOnly, name, now.Location())
+		if err != nil {
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			modified = info.ModTime()
+		}
+		if modified.Before(cutoff) {
+			os.Remove(filepath.Join(s.dir, entry.Name()))
+		}
+	}
+}
+
+func (s *sink) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := errors.Join(s.file.Sync(), s.file.Close())
+	s.file = nil
+	return err
+}
@@ -0,0 +1,97 @@
+package handles
+
+import (
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/database/models"
+	"kano/internal/utils/chatutil/grouputil"
+	"kano/internal/utils/messageutil"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+func isGroupChat(c *messageutil.MessageContext) bool {
+	return c.GetChat().Server == types.GroupServer
+}
+
+// Settings of the current group. The group or its settings may have failed to
+// load with the message, the settings row is created if it doesn't exist yet.
+func groupSettings(c *messageutil.MessageContext) (*grouputil.GroupSettings, error) {
+	if c.Group == nil || c.Group.ID == 0 {
+		return nil, fmt.Errorf("group info is unavailable")
+	}
+	if c.Group.GroupSettings == nil {
+		settings, err := grouputil.InitSettings(c.Group.ID)
+		if err != nil {
+			return nil, err
+		}
+		c.Group.GroupSettings = settings
+	}
+	return c.Group.GroupSettings, nil
+}
+
+// Checks whether the sender may run a command with the given permission,
+// scope and feature in the current chat. Replies with the reason and returns
+// false if not.
+func checkAccess(c *messageutil.MessageContext, perm CommandPermission, scope CommandScope, feature grouputil.Feature) bool {
+	inGroup := isGroupChat(c)
+
+	switch scope {
+	case ScopeGroup:
+		if !inGroup {
+			c.QuoteReplyT("access.group_only")
+			return false
+		}
+	case ScopePrivate:
+		if inGroup {
+			c.QuoteReplyT("access.private_only")
+			return false
+		}
+	}
+
+	isOwner := c.IsSenderSame(config.GetConfig().OwnerJID)
+
+	switch perm {
+	case PermissionOwner:
+		if !isOwner {
+			c.QuoteReplyT("access.owner_only")
+			return false
+		}
+	case PermissionGroupAdmin:
+		if inGroup && !isOwner {
+			if c.Group == nil || c.Contact == nil {
+				c.QuoteReplyT("access.role_unknown")
+				return false
+			}
+			part, err := c.Group.GetParticipantByContactId(c.Contact.ID)
+			if err != nil {
+				c.Logger.Errorf("Failed to get participant info: %s", err)
+				c.QuoteReplyT("access.participant_failed", err)
+				return false
+			}
+			if part.Role != models.ParticipantRoleAdmin && part.Role != models.ParticipantRoleSuperadmin {
+				c.QuoteReplyT("access.admin_only")
+				return false
+			}
+		}
+	}
+
+	if !feature.IsZero() && inGroup {
+		settings, err := groupSettings(c)
+		if err != nil {
+			c.Logger.Errorf("Failed to load group settings: %s", err)
+			c.QuoteReplyT("access.settings_failed")
+			return false
+		}
+		if !settings.IsEnabled(feature) {
+			c.QuoteReplyT("access.feature_disabled", feature.Key, c.Parser.Command.UsedPrefix)
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h CommandHandler) checkAccess(c *messageutil.MessageContext) bool {
+	return checkAccess(c, h.Permission, h.Scope, h.Feature)
+}
@@ -0,0 +1,699 @@
+package handles
+
+import (
+	"context"
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/cronjobs"
+	"kano/internal/database/models"
+	"kano/internal/logger"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/blocklist"
+	"kano/internal/utils/messageutil"
+	"kano/internal/worker"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// Pause between broadcast messages so WhatsApp doesn't flag the bot as spam
+const broadcastDelay = 2 * time.Second
+
+// Budget of a single broadcast message, the whole broadcast gets this plus
+// the delay per recipient
+const broadcastSendTimeout = 30 * time.Second
+
+var adminSubcommands = SubcommandList{
+	{
+		Name:       "reload",
+		Func:       adminReload,
+		Man:        AdminReloadMan,
+		Permission: PermissionOwner,
+	},
+	{
+		Name:       "owneronly",
+		Func:       adminOwnerOnly,
+		Man:        AdminOwnerOnlyMan,
+		Permission: PermissionOwner,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "state", Type: argutil.TypeString},
+			},
+		},
+	},
+	{
+		Name:       "groups",
+		Func:       adminGroups,
+		Man:        AdminGroupsMan,
+		Permission: PermissionOwner,
+	},
+	{
+		Name:       "leave",
+		Func:       adminLeave,
+		Man:        AdminLeaveMan,
+		Permission: PermissionOwner,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "group", Type: argutil.TypeString, Required: true},
+			},
+		},
+	},
+	{
+		Name:       "broadcast",
+		Aliases:    []string{"bc"},
+		Func:       adminBroadcast,
+		Man:        AdminBroadcastMan,
+		Permission: PermissionOwner,
+	},
+	{
+		Name:       "status",
+		Func:       adminStatus,
+		Man:        AdminStatusMan,
+		Permission: PermissionOwner,
+	},
+	{
+		Name:       "loglevel",
+		Aliases:    []string{"log"},
+		Func:       adminLogLevel,
+		Man:        AdminLogLevelMan,
+		Permission: PermissionOwner,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "subsystem", Type: argutil.TypeString},
+				{Name: "level", Type: argutil.TypeString},
+			},
+		},
+	},
+	{
+		Name:       "refresh",
+		Func:       RefreshGroups,
+		Man:        AdminRefreshMan,
+		Permission: PermissionOwner,
+	},
+	{
+		Name:       "block",
+		Aliases:    []string{"ban"},
+		Func:       adminBlock,
+		Man:        AdminBlockMan,
+		Permission: PermissionOwner,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "target", Type: argutil.TypeString, Required: true},
+				{Name: "reason", Type: argutil.TypeString, Variadic: true},
+			},
+			Named: []argutil.Spec{
+				{Name: "for", Type: argutil.TypeDuration},
+			},
+		},
+	},
+	{
+		Name:       "unblock",
+		Aliases:    []string{"unban"},
+		Func:       adminUnblock,
+		Man:        AdminUnblockMan,
+		Permission: PermissionOwner,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "target", Type: argutil.TypeString, Required: true},
+			},
+		},
+	},
+	{
+		Name:       "blocks",
+		Aliases:    []string{"bans"},
+		Func:       adminBlocks,
+		Man:        AdminBlocksMan,
+		Permission: PermissionOwner,
+	},
+}
+
+// Only reached when no subcommand matches
+func AdminHandler(c *messageutil.MessageContext) error {
+	if len(c.Parser.Args) > 0 {
+		c.QuoteReplyT("admin.invalid", c.Parser.Args[0].Content.Data, c.Parser.Command.UsedPrefix)
+		return nil
+	}
+
+	names := make([]string, len(adminSubcommands))
+	for i, sub := range adminSubcommands {
+		names[i] = fmt.Sprintf("`%s`", sub.Name)
+	}
+	c.QuoteReplyT("admin.summary", config.GetConfig().OwnerOnlyMode, strings.Join(names, " "))
+	return nil
+}
+
+func adminReload(c *messageutil.MessageContext) error {
+	if err := config.Reload(); err != nil {
+		c.QuoteReplyT("admin.reload_failed", err)
+		return err
+	}
+
+	conf := config.GetConfig()
+	c.QuoteReplyT("admin.reloaded", conf.OwnerJID, conf.OwnerOnlyMode)
+	return nil
+}
+
+func adminOwnerOnly(c *messageutil.MessageContext) error {
+	state, ok := argutil.Get[string](c.Args, "state")
+	if !ok {
+		c.QuoteReplyT("admin.owneronly.current", config.GetConfig().OwnerOnlyMode)
+		return nil
+	}
+
+	var enabled bool
+	switch strings.ToLower(state) {
+	case "on", "true", "enable":
+		enabled = true
+	case "off", "false", "disable":
+		enabled = false
+	default:
+		c.QuoteReplyT("admin.invalid_state", state)
+		return nil
+	}
+
+	config.SetOwnerOnlyMode(enabled)
+	c.QuoteReplyT("admin.owneronly.set", enabled)
+	return nil
+}
+
+func joinedGroups(c *messageutil.MessageContext) ([]*types.GroupInfo, error) {
+	grps, err := c.Client.GetJoinedGroups()
+	if err != nil {
+		return nil, err
+	}
+	// Keep the numbering stable between `groups` and `leave`
+	slices.SortFunc(grps, func(a, b *types.GroupInfo) int { return strings.Compare(a.JID.String(), b.JID.String()) })
+	return grps, nil
+}
+
+func adminGroups(c *messageutil.MessageContext) error {
+	grps, err := joinedGroups(c)
+	if err != nil {
+		c.QuoteReplyT("admin.groups.failed", err)
+		return err
+	}
+	if len(grps) == 0 {
+		c.QuoteReplyT("admin.groups.empty")
+		return nil
+	}
+
+	var msg strings.Builder
+	msg.WriteString(c.T("admin.groups.header", len(grps)))
+	for i, grp := range grps {
+		msg.WriteString("\n" + c.T("admin.groups.entry", i+1, grp.Name, grp.JID, len(grp.Participants)))
+	}
+
+	c.QuoteReply("%s", msg.String())
+	return nil
+}
+
+func adminLeave(c *messageutil.MessageContext) error {
+	val, _ := argutil.Get[string](c.Args, "group")
+
+	var jid types.JID
+	if idx, err := strconv.Atoi(val); err == nil {
+		grps, err := joinedGroups(c)
+		if err != nil {
+			c.QuoteReplyT("admin.groups.failed", err)
+			return err
+		}
+		if idx < 1 || idx > len(grps) {
+			c.QuoteReplyT("admin.leave.bad_number", len(grps))
+			return nil
+		}
+		jid = grps[idx-1].JID
+	} else {
+		if !strings.Contains(val, "@") {
+			val += "@" + types.GroupServer
+		}
+		jid, err = types.ParseJID(val)
+		if err != nil || jid.Server != types.GroupServer {
+			c.QuoteReplyT("admin.leave.bad_group", val)
+			return nil
+		}
+	}
+
+	if err := c.Client.LeaveGroup(jid); err != nil {
+		c.QuoteReplyT("admin.leave.failed", jid, err)
+		return err
+	}
+
+	c.QuoteReplyT("admin.leave.done", jid)
+	return nil
+}
+
+func adminBroadcast(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
+	// Everything after the subcommand, newlines included
+	raw := strings.TrimSpace(c.Parser.RawArg.Content.Data)
+	_, text, _ := strings.Cut(raw, c.Parser.Args[0].Content.Data)
+	text = strings.TrimSpace(text)
+	if text == "" {
+		c.QuoteReplyT("admin.broadcast.empty")
+		return nil
+	}
+
+	var jids []types.JID
+	tx := db.Model(&models.ClassFollower{}).Distinct("jid").Pluck("jid", &jids)
+	if tx.Error != nil {
+		c.QuoteReplyT("admin.broadcast.failed", tx.Error)
+		return tx.Error
+	}
+	if len(jids) == 0 {
+		c.QuoteReplyT("admin.broadcast.no_followers")
+		return nil
+	}
+
+	c.QuoteReplyT("admin.broadcast.start", len(jids), time.Duration(len(jids))*broadcastDelay)
+
+	// Runs off the chat worker under its own deadline, otherwise it holds the
+	// worker and gets cut off by the handler timeout. The extra send timeout is
+	// left for the final reply.
+	budget := time.Duration(len(jids)) * (broadcastDelay + broadcastSendTimeout)
+	started := worker.Go(budget+broadcastSendTimeout, func(ctx context.Context) {
+		sendCtx, cancel := context.WithTimeout(ctx, budget)
+		sent, err := broadcast(sendCtx, c, jids, text)
+		cancel()
+
+		rc := c.WithContext(ctx)
+		if err != nil {
+			rc.QuoteReplyT("admin.broadcast.stopped", sent, len(jids), err)
+			return
+		}
+		rc.QuoteReplyT("admin.broadcast.done", sent, len(jids))
+	})
+	if !started {
+		return fmt.Errorf("broadcast not started, shutting down")
+	}
+	return nil
+}
+
+// Sends text to every jid until ctx is done. sent counts the successful ones.
+func broadcast(ctx context.Context, c *messageutil.MessageContext, jids []types.JID, text string) (sent int, err error) {
+	cli := c.Client.GetClient()
+	for i, jid := range jids {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return sent, ctx.Err()
+			case <-time.After(broadcastDelay):
+			}
+		}
+
+		_, err := cli.SendMessage(ctx, jid, &waE2E.Message{Conversation: proto.String(text)})
+		if err != nil {
+			if ctx.Err() != nil {
+				return sent, ctx.Err()
+			}
+			c.Logger.Errorf("Failed to broadcast to %s: %s", jid, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func adminStatus(c *messageutil.MessageContext) error {
+	var msg strings.Builder
+
+	msg.WriteString(c.T("admin.status.queue"))
+	if pool := worker.Default(); pool != nil {
+		s := pool.Stats()
+		msg.WriteString("\n" + c.T("admin.status.pool", s.Workers, s.Running, s.Queued, s.MaxQueued, s.Rejected))
+	} else {
+		msg.WriteString("\n" + c.T("admin.status.not_started"))
+	}
+
+	msg.WriteString("\n\n" + c.T("admin.status.cron"))
+	jobs := cronjobs.Status()
+	if len(jobs) == 0 {
+		msg.WriteString("\n" + c.T("admin.status.no_cron"))
+	}
+	for _, j := range jobs {
+		prev := c.T("admin.status.never")
+		if !j.Prev.IsZero() {
+			prev = j.Prev.Format(time.DateTime)
+		}
+		msg.WriteString("\n" + c.T("admin.status.job", j.Name, j.Spec, j.Next.Format(time.DateTime), prev, j.LastDuration.Round(time.Millisecond), j.Runs))
+		if j.Running {
+			msg.WriteString(c.T("admin.status.running"))
+		}
+	}
+
+	msg.WriteString("\n\n" + c.T("admin.owneronly.current", config.GetConfig().OwnerOnlyMode))
+
+	c.QuoteReply("%s", msg.String())
+	return nil
+}
+
+func adminLogLevel(c *messageutil.MessageContext) error {
+	subsystem, hasSubsystem := argutil.Get[string](c.Args, "subsystem")
+	value, hasLevel := argutil.Get[string](c.Args, "level")
+
+	if hasSubsystem && !hasLevel {
+		// `admin loglevel info` changes the default level
+		level, err := logger.ParseLevel(subsystem)
+		if err != nil {
+			c.QuoteReply("%s", err)
+			return nil
+		}
+		_, subs := logger.Levels()
+		logger.SetLevels(level, subs)
+	} else if hasLevel {
+		if strings.EqualFold(value, "reset") {
+			logger.ResetLevel(subsystem)
+		} else {
+			level, err := logger.ParseLevel(value)
+			if err != nil {
+				c.QuoteReply("%s", err)
+				return nil
+			}
+			logger.SetLevel(subsystem, level)
+		}
+	}
+
+	def, subs := logger.Levels()
+	var msg strings.Builder
+	msg.WriteString(c.T("admin.loglevel.default", def))
+	for _, name := range slices.Sorted(maps.Keys(subs)) {
+		fmt.Fprintf(&msg, "\n- %s: *%s*", name, subs[name])
+	}
+	if hasSubsystem {
+		msg.WriteString("\n\n" + c.T("admin.loglevel.temporary"))
+	}
+
+	c.QuoteReply("%s", msg.String())
+	return nil
+}
+
+// Accepts a mention, a phone number, a group or contact JID, or `here` for
+// the current chat
+func blockTarget(c *messageutil.MessageContext, val string) (types.JID, error) {
+	if strings.EqualFold(val, "here") {
+		return c.Info.Chat, nil
+	}
+	if strings.HasPrefix(val, "@") {
+		return argutil.ParseMention(val)
+	}
+	if !strings.Contains(val, "@") {
+		if _, err := strconv.ParseUint(val, 10, 64); err != nil {
+			return types.EmptyJID, fmt.Errorf("not a mention, number or JID")
+		}
+		return types.NewJID(val, types.DefaultUserServer), nil
+	}
+	return types.ParseJID(val)
+}
+
+func adminBlock(c *messageutil.MessageContext) error {
+	val, _ := argutil.Get[string](c.Args, "target")
+	target, err := blockTarget(c, val)
+	if err != nil {
+		c.QuoteReplyT("admin.block.bad_target", val, err)
+		return nil
+	}
+	if isOwnerJID(c, target) || target.User == c.Client.GetJID().User || target.User == c.Client.GetLID().User {
+		c.QuoteReplyT("admin.block.refused")
+		return nil
+	}
+
+	reason := strings.Join(argutil.GetAll[string](c.Args, "reason"), " ")
+	var expiresAt time.Time
+	if dur, ok := argutil.Get[time.Duration](c.Args, "for"); ok {
+		if dur <= 0 {
+			c.QuoteReplyT("admin.block.bad_duration")
+			return nil
+		}
+		expiresAt = time.Now().Add(dur)
+	}
+
+	if err := blocklist.Block(c.Context(), target, reason, expiresAt); err != nil {
+		c.QuoteReplyT("admin.block.failed", target, err)
+		return err
+	}
+
+	c.QuoteReplyT("admin.block.done", target, blockUntil(c, expiresAt))
+	return nil
+}
+
+func adminUnblock(c *messageutil.MessageContext) error {
+	val, _ := argutil.Get[string](c.Args, "target")
+	target, err := blockTarget(c, val)
+	if err != nil {
+		c.QuoteReplyT("admin.block.bad_target", val, err)
+		return nil
+	}
+
+	ok, err := blocklist.Unblock(c.Context(), target)
+	if err != nil {
+		c.QuoteReplyT("admin.unblock.failed", target, err)
+		return err
+	}
+	if !ok {
+		c.QuoteReplyT("admin.unblock.not_blocked", target)
+		return nil
+	}
+
+	c.QuoteReplyT("admin.unblock.done", target)
+	return nil
+}
+
+func adminBlocks(c *messageutil.MessageContext) error {
+	blocked, err := blocklist.List(c.Context())
+	if err != nil {
+		c.QuoteReplyT("admin.blocks.failed", err)
+		return err
+	}
+	if len(blocked) == 0 {
+		c.QuoteReplyT("admin.blocks.empty")
+		return nil
+	}
+
+	var msg strings.Builder
+	msg.WriteString(c.T("admin.blocks.header", len(blocked)))
+	for i, b := range blocked {
+		reason := b.Reason
+		if reason == "" {
+			reason = c.T("admin.blocks.no_reason")
+		}
+		var until time.Time
+		if b.ExpiresAt.Valid {
+			until = b.ExpiresAt.Time
+		}
+		fmt.Fprintf(&msg, "\n%d. %s %s\n   %s", i+1, b.JID, blockUntil(c, until), reason)
+	}
+
+	c.QuoteReply("%s", msg.String())
+	return nil
+}
+
+func blockUntil(c *messageutil.MessageContext, t time.Time) string {
+	if t.IsZero() {
+		return c.T("admin.block.forever")
+	}
+	return c.T("admin.block.until", t.Format(time.DateTime))
+}
+
+// Mentions are LIDs while OWNER_JID is usually a phone number
+func isOwnerJID(c *messageutil.MessageContext, jid types.JID) bool {
+	owner := config.GetConfig().OwnerJID
+	if jid.User == owner.User {
+		return true
+	}
+	if jid.Server == types.HiddenUserServer {
+		if pn, err := c.Client.GetPNForLID(jid); err == nil && pn.User == owner.User {
+			return true
+		}
+	}
+	return false
+}
+
+var AdminMan = CommandMan{
+	Name: "admin - bot owner tools",
+	Synopsis: []string{
+		"*admin*",
+		"*admin* *reload*",
+		"*admin* *owneronly* [ *on*|*off* ]",
+		"*admin* *groups*",
+		"*admin* *leave* _group_",
+		"*admin* *broadcast* _message_",
+		"*admin* *status*",
+		"*admin* *loglevel* [ _subsystem_ ] [ _level_|*reset* ]",
+		"*admin* *refresh*",
+		"*admin* *block* _target_ [ *for*=_duration_ ] [ _reason_ ... ]",
+		"*admin* *unblock* _target_",
+		"*admin* *blocks*",
+	},
+	Description: []string{
+		"Collection of tools to manage the bot at runtime. Can only be executed by the owner of the bot.",
+		"Without arguments, shows whether owner only mode is on and the list of subcommands.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso: []SeeAlso{
+		{"stats", SeeAlsoTypeCommand},
+	},
+}
+
+var AdminReloadMan = CommandMan{
+	Name: "admin reload - reload the config",
+	Synopsis: []string{
+		"*admin* *reload*",
+	},
+	Description: []string{
+		"Reads the .env file and the environment again. If the new config is invalid, the old one is kept. The database connection is not reopened, so changing DATABASE_URL still needs a restart.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminOwnerOnlyMan = CommandMan{
+	Name: "admin owneronly - toggle owner only mode",
+	Synopsis: []string{
+		"*admin* *owneronly* [ *on*|*off* ]",
+	},
+	Description: []string{
+		"When on, the bot ignores everyone except its owner. The change lasts until the next `admin reload` or restart, set OWNER_ONLY in the environment to make it permanent.",
+		"Without arguments, shows the current state.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminGroupsMan = CommandMan{
+	Name: "admin groups - list joined groups",
+	Synopsis: []string{
+		"*admin* *groups*",
+	},
+	Description: []string{
+		"Lists every group the bot is in, numbered, with their JID and member count. The numbers can be given to `admin leave`.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminLeaveMan = CommandMan{
+	Name: "admin leave - leave a group",
+	Synopsis: []string{
+		"*admin* *leave* _group_",
+	},
+	Description: []string{
+		"_group_" +
+			"\n{SPACE}Number of the group from `admin groups`, or its JID. The `@g.us` part can be left out." +
+			"\n{SPACE}Example: `admin leave 3`, `admin leave 120363000000000000`.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminBroadcastMan = CommandMan{
+	Name: "admin broadcast - message every class follower",
+	Synopsis: []string{
+		"*admin* *broadcast* _message_",
+		"*admin* *bc* _message_",
+	},
+	Description: []string{
+		"Sends _message_ to everyone following at least one class with `six follow`, once per person. Messages are sent a few seconds apart in the background, and the bot replies once it is done, or with how far it got if it had to stop.",
+		"_message_" +
+			"\n{SPACE}Everything after the subcommand, newlines and formatting included.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso: []SeeAlso{
+		{"six", SeeAlsoTypeCommand},
+	},
+}
+
+var AdminStatusMan = CommandMan{
+	Name: "admin status - queue and cron status",
+	Synopsis: []string{
+		"*admin* *status*",
+	},
+	Description: []string{
+		"Shows the message queue load and when each cron job last ran and runs next.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminLogLevelMan = CommandMan{
+	Name: "admin loglevel - change log levels at runtime",
+	Synopsis: []string{
+		"*admin* *loglevel*",
+		"*admin* *loglevel* _level_",
+		"*admin* *loglevel* _subsystem_ _level_|*reset*",
+	},
+	Description: []string{
+		"Without arguments, shows the default log level and the subsystem overrides. Changes last until the next `admin reload` or restart, set LOG_LEVEL in the environment to make them permanent.",
+		"_level_" +
+			"\n{SPACE}One of `debug`, `info`, `warn` or `error`. Given alone, it becomes the default level.",
+		"_subsystem_" +
+			"\n{SPACE}Subsystem as written in the logs. Its level applies to everything under it, `reset` makes it follow its parent again." +
+			"\n{SPACE}Example: `admin loglevel Kano/LogEvent warn`, `admin loglevel Kano/Message reset`.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminRefreshMan = CommandMan{
+	Name: "admin refresh - refresh group members",
+	Synopsis: []string{
+		"*admin* *refresh*",
+	},
+	Description: []string{
+		"Fetches the name and participants of every known group again and updates the database. Same as `rg`.",
+	},
+	SourceFilename: "refresh-groups.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminBlockMan = CommandMan{
+	Name: "admin block - ignore a contact or group",
+	Synopsis: []string{
+		"*admin* *block* _target_ [ *for*=_duration_ ] [ _reason_ ... ]",
+	},
+	Description: []string{
+		"Adds _target_ to the blocklist. The bot ignores every message and reaction from a blocked contact, and everything sent in a blocked group. The owner is never ignored. Blocking an already blocked target replaces its reason and expiry. Useful against spam groups and confess abuse.",
+		"_target_" +
+			"\n{SPACE}Mention of a contact, a phone number, a contact or group JID, or `here` for the current chat." +
+			"\n{SPACE}Example: `@kano`, `6281234567890`, `120363000000000000@g.us`.",
+		"_duration_" +
+			"\n{SPACE}Optional. How long the block lasts, forever if left out. A bare number is taken as minutes, units *d*, *h* and *m* can be combined." +
+			"\n{SPACE}Example: `for=7d`, `for=12h`.",
+		"_reason_" +
+			"\n{SPACE}Optional. Note shown in `admin blocks`.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso: []SeeAlso{
+		{"admin unblock", SeeAlsoTypeCommand},
+		{"admin blocks", SeeAlsoTypeCommand},
+	},
+}
+
+var AdminUnblockMan = CommandMan{
+	Name: "admin unblock - remove from the blocklist",
+	Synopsis: []string{
+		"*admin* *unblock* _target_",
+	},
+	Description: []string{
+		"_target_" +
+			"\n{SPACE}Same as in `admin block`.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var AdminBlocksMan = CommandMan{
+	Name: "admin blocks - show the blocklist",
+	Synopsis: []string{
+		"*admin* *blocks*",
+	},
+	Description: []string{
+		"Lists the blocked contacts and groups with their reason and expiry, newest first. Expired entries are cleaned up on the way.",
+	},
+	SourceFilename: "admin.go",
+	SeeAlso:        []SeeAlso{},
+}
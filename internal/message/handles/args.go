@@ -0,0 +1,39 @@
+package handles
+
+import (
+	"fmt"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/messageutil"
+	"strings"
+)
+
+// Validates the arguments against the schema and stores the result in
+// c.Args. On failure, replies with the reason and the usage from the manual.
+func validateArgs(c *messageutil.MessageContext, schema argutil.Schema, offset int, man CommandMan) bool {
+	if schema.IsZero() {
+		return true
+	}
+
+	values, err := schema.Validate(c.Parser, offset)
+	if err != nil {
+		c.QuoteReplyT("args.invalid", err, usageText(c.T("args.usage"), c.Parser.Command.UsedPrefix, man))
+		return false
+	}
+
+	c.Args = values
+	return true
+}
+
+// Usage lines built from the manual synopsis
+func usageText(title, pref string, man CommandMan) string {
+	if len(man.Synopsis) == 0 {
+		return ""
+	}
+
+	var msg strings.Builder
+	msg.WriteString(title)
+	for _, s := range man.Synopsis {
+		fmt.Fprintf(&msg, "\n%s%s", pref, s)
+	}
+	return msg.String()
+}
@@ -1,9 +1,9 @@
 package handles
 
 import (
-	"context"
 	"database/sql"
 	"kano/internal/database/models"
+	"kano/internal/utils/chatutil/grouputil"
 	"kano/internal/utils/messageutil"
 	"slices"
 
@@ -15,26 +15,22 @@ import (
 )
 
 func Confess(c *messageutil.MessageContext) error {
-	if c.Group != nil {
-		return nil
-	}
-
+	confessCond, confessArgs := grouputil.FeatureCondition(FeatureConfess, "participant.group_id")
 	part, err := gorm.G[models.Participant](db).
 		Joins(clause.InnerJoin.Association("Group"), models.NoopJoin).
-		Joins(clause.InnerJoin.Association("GroupSettings"), models.NoopJoin).
 		Where(`"Group".is_announcement != TRUE`).
-		Where(`"GroupSettings".is_confess_allowed = TRUE`).
+		Where(confessCond, confessArgs...).
 		Where("contact_id = ?", c.Contact.ID).
 		Where("role != ?", models.ParticipantRoleLeft).
-		Find(context.Background())
+		Find(c.Context())
 
 	if err != nil {
-		c.QuoteReply("Internal error.\nDebug: %s", err)
+		c.QuoteReplyT("common.internal_error", err)
 		return err
 	}
 
 	if len(part) == 0 {
-		c.QuoteReply("You are not joining groups or not all group allowing confess.")
+		c.QuoteReplyT("confess.no_group")
 		return nil
 	}
 
@@ -53,13 +49,13 @@ func Confess(c *messageutil.MessageContext) error {
 	}
 
 	if grpJid == types.EmptyJID {
-		c.QuoteReply("I seem to see you in multiple groups, please set it up first using `.confesstarget`")
+		c.QuoteReplyT("confess.many_groups")
 		return nil
 	}
 
 	args := c.Parser.RawArg.Content.Data
 	if len(args) == 0 && c.Event.Message.GetExtendedTextMessage().GetContextInfo().GetQuotedMessage() == nil {
-		c.QuoteReply("Give the confess message.")
+		c.QuoteReplyT("confess.empty")
 		return nil
 	}
 
@@ -137,7 +133,7 @@ func Confess(c *messageutil.MessageContext) error {
 	}
 
 	if !canGoWithoutArgs && len(args) == 0 {
-		c.QuoteReply("Give the confess message.")
+		c.QuoteReplyT("confess.empty")
 		return nil
 	} else {
 		if len(args) == 0 {
@@ -155,3 +151,22 @@ func Confess(c *messageutil.MessageContext) error {
 
 	return nil
 }
+
+var ConfessMan = CommandMan{
+	Name: "confess - send an anonymous message to a group",
+	Synopsis: []string{
+		"*confess* _message_",
+	},
+	Description: []string{
+		"Sends _message_ to one of your groups without telling who wrote it. Can only be used in private chat, and only reaches groups where confessions are enabled with `enable confess`.",
+		"Reply to a message with `confess` to send its media, stickers included, along with the text. The text can be left out when replying to media.",
+		"If you are in more than one group that allows confessions, choose one first with `confesstarget`.",
+		"_message_" +
+			"\n{SPACE}Everything after the command, newlines and formatting included.",
+	},
+	SourceFilename: "confess.go",
+	SeeAlso: []SeeAlso{
+		{"confesstarget", SeeAlsoTypeCommand},
+		{"enable", SeeAlsoTypeCommand},
+	},
+}
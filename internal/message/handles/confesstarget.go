@@ -1,10 +1,10 @@
 package handles
 
 import (
-	"context"
 	"database/sql"
 	"fmt"
 	"kano/internal/database/models"
+	"kano/internal/utils/chatutil/grouputil"
 	"kano/internal/utils/messageutil"
 	"slices"
 	"strconv"
@@ -15,25 +15,21 @@ import (
 )
 
 func ConfessTargetHandler(c *messageutil.MessageContext) error {
-	if c.Group != nil {
-		return nil
-	}
-
+	confessCond, confessArgs := grouputil.FeatureCondition(FeatureConfess, "participant.group_id")
 	part, err := gorm.G[models.Participant](db).
 		Joins(clause.InnerJoin.Association("Group"), models.NoopJoin).
-		Joins(clause.InnerJoin.Association("GroupSettings"), models.NoopJoin).
 		Where(`"Group".is_announcement != TRUE`).
-		Where(`"GroupSettings".is_confess_allowed = TRUE`).
+		Where(confessCond, confessArgs...).
 		Where("contact_id = ?", c.Contact.ID).
 		Where("role != ?", models.ParticipantRoleLeft).
-		Find(context.Background())
+		Find(c.Context())
 
 	if err != nil {
-		c.QuoteReply("Internal error.\nDebug: %s", err)
+		c.QuoteReplyT("common.internal_error", err)
 		return err
 	}
 	if len(part) == 0 {
-		c.QuoteReply("You are not joining groups or not all group allowing confess.")
+		c.QuoteReplyT("confess.no_group")
 		return nil
 	}
 
@@ -56,12 +52,12 @@ func ConfessTargetHandler(c *messageutil.MessageContext) error {
 
 	if idx == -1 {
 		var msg strings.Builder
-		msg.WriteString("Use `.confesstarget group_id` to set the confess target.")
+		msg.WriteString(c.T("confess.target.usage"))
 		if c.Contact.ConfessTarget.Valid {
-			fmt.Fprintf(&msg, "\nYour current selection: %d - %q", part[settingsIdx].GroupID, part[settingsIdx].Group.Name)
+			msg.WriteString("\n" + c.T("confess.target.current", part[settingsIdx].GroupID, part[settingsIdx].Group.Name))
 		}
 
-		msg.WriteString("\n\nAvailable groups:")
+		msg.WriteString("\n\n" + c.T("confess.target.available"))
 		for _, p := range part {
 			fmt.Fprintf(&msg, "\n%d - %q", p.GroupID, p.Group.Name)
 		}
@@ -72,19 +68,29 @@ func ConfessTargetHandler(c *messageutil.MessageContext) error {
 		c.Contact.ConfessTarget.Int32 = int32(part[idx].GroupID)
 		err := c.Contact.Save()
 		if err != nil {
-			c.QuoteReply("Failed to save contact info: %s", err)
+			c.QuoteReplyT("confess.target.save_failed", err)
 			return err
 		}
-		c.QuoteReply("Succesfully set the confess target into %d - %q", part[idx].GroupID, part[idx].Group.Name)
+		c.QuoteReplyT("confess.target.saved", part[idx].GroupID, part[idx].Group.Name)
 	}
 
 	return nil
 }
 
 var ConfessTargetMan = CommandMan{
-	Name:           "",
-	Synopsis:       []string{},
-	Description:    []string{},
+	Name: "confesstarget - choose where confessions go",
+	Synopsis: []string{
+		"*confesstarget* [ _group_id_ ]",
+	},
+	Description: []string{
+		"Chooses the group your confessions are sent to. Only needed if you are in more than one group that allows confessions. Can only be used in private chat.",
+		"Without arguments, lists the groups you can confess to with their ID, and your current choice.",
+		"_group_id_" +
+			"\n{SPACE}ID of the group, as shown in the list." +
+			"\n{SPACE}Example: `confesstarget 12`.",
+	},
 	SourceFilename: "confesstarget.go",
-	SeeAlso:        []SeeAlso{},
+	SeeAlso: []SeeAlso{
+		{"confess", SeeAlsoTypeCommand},
+	},
 }
@@ -2,6 +2,8 @@ package handles
 
 import (
 	"fmt"
+	"io"
+	"kano/internal/config"
 	"kano/internal/utils/downloader"
 	"kano/internal/utils/downloader/types"
 	"kano/internal/utils/messageutil"
@@ -13,6 +15,28 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// Fails instead of truncating, a cut off file would upload as broken media
+type cappedReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (c *cappedReader) Read(p []byte) (int, error) {
+	if c.left <= 0 {
+		var probe [1]byte
+		if n, _ := c.r.Read(probe[:]); n > 0 {
+			return 0, fmt.Errorf("media is larger than %d MB", config.GetConfig().DownloadMaxBytes>>20)
+		}
+		return 0, io.EOF
+	}
+	if int64(len(p)) > c.left {
+		p = p[:c.left]
+	}
+	n, err := c.r.Read(p)
+	c.left -= int64(n)
+	return n, err
+}
+
 func uploadMedias(c *messageutil.MessageContext, media types.DownloaderMedia) (*waE2E.Message, error) {
 	defer media.Close()
 
@@ -25,7 +49,7 @@ func uploadMedias(c *messageutil.MessageContext, media types.DownloaderMedia) (*
 		mediaType = whatsmeow.MediaVideo
 	}
 
-	upResp, err := c.Client.UploadReader(media, mediaType)
+	upResp, err := c.Client.UploadReader(&cappedReader{r: media, left: config.GetConfig().DownloadMaxBytes}, mediaType)
 	if err != nil {
 		return nil, err
 	}
@@ -64,31 +88,18 @@ func uploadMedias(c *messageutil.MessageContext, media types.DownloaderMedia) (*
 func DownloadHandler(c *messageutil.MessageContext) error {
 	url := c.Parser.RawArg.Content.Data
 	if len(url) == 0 {
-		c.QuoteReply("Give url (currently supports: instagram, tiktok, youtube[pls don't])")
+		c.QuoteReplyT("download.no_url")
 		return nil
 	}
 
 	if strings.Contains(url, "youtube.com") || strings.Contains(url, "youtu.be") {
-		c.QuoteReply("Youtube support is currently dropped")
+		c.QuoteReplyT("download.no_youtube")
 		return nil
 	}
 
-	rectMsg := &waE2E.Message{
-		ReactionMessage: &waE2E.ReactionMessage{
-			Key: &waCommon.MessageKey{
-				RemoteJID: proto.String(c.GetChat().String()),
-				FromMe:    proto.Bool(false),
-				ID:        proto.String(c.GetID()),
-			},
-			Text: proto.String("⏳"),
-		},
-	}
-	if c.Group != nil {
-		rectMsg.ReactionMessage.Key.Participant = proto.String(c.GetSender().String())
-	}
-	c.SendMessage(rectMsg)
+	c.React("⏳")
 
-	downloaded, err := downloader.Download(url)
+	downloaded, err := downloader.Download(c.Context(), url)
 	if err != nil {
 		c.QuoteReply("%s", err)
 		return nil
@@ -106,7 +117,7 @@ func DownloadHandler(c *messageutil.MessageContext) error {
 	for i, med := range medias {
 		msg, err := uploadMedias(c, med)
 		if err != nil {
-			c.QuoteReply("failed to upload media: %s", err)
+			c.QuoteReplyT("download.upload_failed", err)
 			return err
 		}
 
@@ -165,3 +176,18 @@ func DownloadHandler(c *messageutil.MessageContext) error {
 
 	return nil
 }
+
+var DownloadMan = CommandMan{
+	Name: "download - download media from a post",
+	Synopsis: []string{
+		"*download* _url_",
+	},
+	Description: []string{
+		"Downloads the photos and videos of an Instagram or TikTok post and sends them here. YouTube is not supported for now.",
+		"_url_" +
+			"\n{SPACE}Link to the post, must start with https." +
+			"\n{SPACE}Example: `https://www.instagram.com/p/XXXXXXXXXXX/`.",
+	},
+	SourceFilename: "download.go",
+	SeeAlso:        []SeeAlso{},
+}
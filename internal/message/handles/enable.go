@@ -1,88 +1,86 @@
 package handles
 
 import (
-	"kano/internal/database/models"
+	"fmt"
+	"kano/internal/utils/chatutil/grouputil"
 	"kano/internal/utils/messageutil"
-	"slices"
 	"strings"
 )
 
 func EnableHandler(c *messageutil.MessageContext) error {
-	if c.Group == nil {
-		return nil
-	}
-	part, err := c.Group.GetParticipantByContactId(c.Contact.ID)
+	isEnable := c.Parser.Command.Name.Data == "enable"
+
+	settings, err := groupSettings(c)
 	if err != nil {
-		c.QuoteReply("Failed to get participant info: %s", err)
+		c.QuoteReplyT("access.settings_failed")
 		return err
 	}
-	role := part.Role
-	if role != models.ParticipantRoleAdmin && role != models.ParticipantRoleSuperadmin {
-		c.QuoteReply("Your role in this group is not admin or superadmin. (%d=%s)", part.ID, role)
-		return nil
-	}
-
-	isEnable := c.Parser.Command.Name.Data == "enable"
-	allowedArgs := []string{"game", "confess"}
 
 	args := c.Parser.Args
 	if len(args) == 0 {
-		// Show the current configuration
-		c.QuoteReply(
-			"Current configuration:\n\n"+
-				"[game] Is Game Allowed? %t\n"+
-				"[confess] Is Confess Allowed? %t",
-			c.Group.GroupSettings.IsGameAllowed,
-			c.Group.GroupSettings.IsConfessAllowed,
-		)
-	} else {
-		p := strings.ToLower(args[0].Content.Data)
-		if !slices.Contains(allowedArgs, p) {
-			c.QuoteReply("Invalid argument. Accepted arguments are: %s.", strings.Join(allowedArgs, "; "))
-		} else {
-			switch p {
-			case "game":
-				c.Group.GroupSettings.IsGameAllowed = isEnable
-			case "confess":
-				c.Group.GroupSettings.IsConfessAllowed = isEnable
-			default:
-				c.QuoteReply("Unhandled argument %q. Please report it to the developer!", p)
-				return nil
-			}
+		c.QuoteReplyT("enable.current", featureList(c, settings))
+		return nil
+	}
 
-			err := c.Group.GroupSettings.Save()
-			if err != nil {
-				c.QuoteReply("Failed to save group settings: %s", err)
-				return err
-			}
+	// Validate everything first so a typo doesn't leave a half applied change
+	toggled := make([]grouputil.Feature, 0, len(args))
+	for _, arg := range args {
+		f, ok := grouputil.LookupFeature(arg.Content.Data)
+		if !ok {
+			c.QuoteReplyT("enable.invalid", arg.Content.Data, featureKeys())
+			return nil
+		}
+		toggled = append(toggled, f)
+	}
 
-			c.QuoteReply(
-				"Configuration saved! Current configuration:\n\n"+
-					"[game] Is Game Allowed? %t\n"+
-					"[confess] Is Confess Allowed? %t",
-				c.Group.GroupSettings.IsGameAllowed,
-				c.Group.GroupSettings.IsConfessAllowed,
-			)
+	for _, f := range toggled {
+		if err := settings.SetEnabled(f, isEnable); err != nil {
+			c.QuoteReplyT("enable.save_failed", err)
+			return err
 		}
 	}
 
+	c.QuoteReplyT("enable.saved", featureList(c, settings))
 	return nil
 }
 
+func featureList(c *messageutil.MessageContext, settings *grouputil.GroupSettings) string {
+	var msg strings.Builder
+	for _, f := range grouputil.Features() {
+		state := c.T("enable.off")
+		if settings.IsEnabled(f) {
+			state = c.T("enable.on")
+		}
+		fmt.Fprintf(&msg, "\n[%s] %s: *%s*", f.Key, c.T(f.Description), state)
+	}
+	return msg.String()
+}
+
+func featureKeys() string {
+	features := grouputil.Features()
+	keys := make([]string, len(features))
+	for i, f := range features {
+		keys[i] = fmt.Sprintf("`%s`", f.Key)
+	}
+	return strings.Join(keys, ", ")
+}
+
 var EnableMan = CommandMan{
 	Name: "enable - enable feature",
 	Synopsis: []string{
-		"*enable* [ _config_name_ ]",
-		"*disable* [ _config_name_ ]",
+		"*enable* [ _feature_ ... ]",
+		"*disable* [ _feature_ ... ]",
 	},
 	Description: []string{
-		"Configure your group to enable some feature. Use `disable` to do the opposite.",
-		"_config_name_" +
-			"\n{SPACE}Currently only accepts `game` and `confess`. `game` to enable game in the group and `confess` to allow user make a confess to this group.",
+		"Configure your group to enable some feature. Use `disable` to do the opposite. Without arguments, lists every feature with its current state.",
+		"_feature_" +
+			"\n{SPACE}Key of the feature, as shown in the list, e.g. `game`, `confess`, `suggest` or `download`. More than one can be given at once." +
+			"\n{SPACE}Example: `enable game confess`, `disable vo`.",
 	},
 	SourceFilename: "enable.go",
 	SeeAlso: []SeeAlso{
 		{"game", SeeAlsoTypeCommand},
 		{"confess", SeeAlsoTypeCommand},
+		{"prefix", SeeAlsoTypeCommand},
 	},
 }
@@ -0,0 +1,29 @@
+package handles
+
+import (
+	"kano/internal/config"
+	"kano/internal/logger"
+	"kano/internal/utils/chatutil/grouputil"
+)
+
+// Group features declared by the commands, `.enable` picks them up from the
+// registry
+var (
+	FeatureGame     = grouputil.RegisterFeature(grouputil.Feature{Key: "game", Description: "feature.game"})
+	FeatureConfess  = grouputil.RegisterFeature(grouputil.Feature{Key: "confess", Description: "feature.confess"})
+	FeatureSuggest  = grouputil.RegisterFeature(grouputil.Feature{Key: "suggest", Description: "feature.suggest", Default: true})
+	FeatureDownload = grouputil.RegisterFeature(grouputil.Feature{Key: "download", Description: "feature.download", Default: true})
+	FeatureSticker  = grouputil.RegisterFeature(grouputil.Feature{Key: "stk", Description: "feature.stk", Default: true})
+	FeatureTa       = grouputil.RegisterFeature(grouputil.Feature{Key: "ta", Description: "feature.ta", Default: true})
+	FeatureViewOnce = grouputil.RegisterFeature(grouputil.Feature{Key: "vo", Description: "feature.vo", Default: true})
+)
+
+// Feature defaults in the config only take effect for registered keys, a
+// typo would otherwise be silently ignored
+func checkFeatureDefaults(log *logger.Logger) {
+	for key := range config.GetConfig().FeatureDefaults {
+		if _, ok := grouputil.LookupFeature(key); !ok {
+			log.Warnf("Config sets the default of unknown feature %q", key)
+		}
+	}
+}
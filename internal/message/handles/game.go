@@ -1,7 +1,6 @@
 package handles
 
 import (
-	"kano/internal/database/models"
 	"kano/internal/utils/messageutil"
 	"slices"
 	"strings"
@@ -11,25 +10,15 @@ func GameHandler(c *messageutil.MessageContext) error {
 	enables := []string{"true", "on", "yes", "1"}
 	disables := []string{"false", "off", "no", "0"}
 
-	if c.Group == nil {
-		c.QuoteReply("You can only set game allowance in groups.")
-		return nil
-	}
-
-	part, err := c.Group.GetParticipantByContactId(c.Contact.ID)
+	settings, err := groupSettings(c)
 	if err != nil {
-		c.QuoteReply("Failed to get participant info: %s", err)
+		c.QuoteReplyT("access.settings_failed")
 		return err
 	}
-	role := part.Role
-	if role != models.ParticipantRoleAdmin && role != models.ParticipantRoleSuperadmin {
-		c.QuoteReply("Your role in this group is not admin or superadmin.")
-		return nil
-	}
 
 	args := c.Parser.Args
 	if len(args) == 0 {
-		c.QuoteReply("Is game allowed in this group? %t", c.Group.GroupSettings.IsGameAllowed)
+		c.QuoteReplyT("game.current", settings.IsEnabled(FeatureGame))
 		return nil
 	}
 
@@ -39,31 +28,25 @@ func GameHandler(c *messageutil.MessageContext) error {
 	isDisabled := slices.Contains(disables, inp)
 
 	if !isEnabled && !isDisabled {
-		c.QuoteReply("Input is not valid.\nUse %s to enable.\nUse %s to disable.", strings.Join(enables, "/"), strings.Join(disables, "/"))
+		c.QuoteReplyT("game.invalid", strings.Join(enables, "/"), strings.Join(disables, "/"))
 		return nil
 	}
 
 	if isEnabled && isDisabled {
-		c.QuoteReply("Internal error: isEnabled and isDisabled are both true.")
+		c.QuoteReplyT("game.both")
 		return nil
 	}
 
-	e := ""
-	if isEnabled {
-		c.Group.GroupSettings.IsGameAllowed = true
-		e = "enabled"
-	}
-	if isDisabled {
-		c.Group.GroupSettings.IsGameAllowed = false
-		e = "disabled"
-	}
-
-	err = c.Group.GroupSettings.Save()
+	err = settings.SetEnabled(FeatureGame, isEnabled)
 	if err != nil {
-		c.QuoteReply("Internal error.\nDebug: %s", err)
+		c.QuoteReplyT("common.internal_error", err)
 		return err
 	}
-	c.QuoteReply("Game is %s in this group from now.", e)
+	if isEnabled {
+		c.QuoteReplyT("game.enabled")
+	} else {
+		c.QuoteReplyT("game.disabled")
+	}
 
 	return nil
 }
@@ -2,16 +2,65 @@ package handles
 
 import (
 	"fmt"
+	"kano/internal/logger"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/i18n"
 	"kano/internal/utils/messageutil"
+	"maps"
+	"slices"
 	"strings"
+	"unicode/utf8"
 )
 
 const SPACE_INDENT = 6
 
-// TODO: Add webpage to see the help message since the message
-// display may vary depending on device's screen size
+// Longer manuals are split into pages, WhatsApp folds long messages anyway
+const helpPageSize = 2000
 
-var commandListStr string = ""
+// Sorted command names per category, filled in init. Uncategorized commands
+// are under the empty category.
+var helpIndex = map[CommandCategory][]string{}
+
+func indexHelp() {
+	for name, cmd := range mappedCommands {
+		if commandNames[name] != name {
+			continue // alias
+		}
+		helpIndex[cmd.Category] = append(helpIndex[cmd.Category], name)
+	}
+	for _, names := range helpIndex {
+		slices.Sort(names)
+	}
+}
+
+// Commands without a manual show "Docs entry is empty" in help, the owner
+// should know about them
+func checkManuals(log *logger.Logger) {
+	names := slices.Sorted(maps.Keys(mappedCommands))
+	for _, name := range names {
+		if commandNames[name] != name {
+			continue
+		}
+		cmd := mappedCommands[name]
+		if cmd.Man.Name == "" {
+			log.Warnf("Command %s has no manual", name)
+		}
+		for _, sub := range cmd.Subcommands {
+			if sub.Man.Name == "" {
+				log.Warnf("Subcommand %s %s has no manual", name, sub.Name)
+			}
+		}
+	}
+}
+
+func lookupCategory(name string) (CommandCategory, bool) {
+	for _, cat := range Categories {
+		if strings.EqualFold(string(cat), name) {
+			return cat, true
+		}
+	}
+	return "", false
+}
 
 func HelpHandler(c *messageutil.MessageContext) error {
 	// if ctx.Instance.ChatJID().Server != types.DefaultUserServer {
@@ -20,89 +69,173 @@ func HelpHandler(c *messageutil.MessageContext) error {
 	// }
 
 	args := c.Parser.Args
-	if len(args) > 0 {
-		queryCommand := args[0].Content.Data
-		foundCommand, ok := mappedCommands[queryCommand]
-		if !ok {
-			c.QuoteReply("Command \"%s\" is not found!", queryCommand)
-			return nil
+	if len(args) == 0 {
+		prefixes := make([]string, len(c.Parser.Prefixes))
+		for i, p := range c.Parser.Prefixes {
+			prefixes[i] = fmt.Sprintf("`%s`", p)
 		}
 
-		commandMan := foundCommand.Man
-		if len(commandMan.Name) == 0 {
-			c.QuoteReply("Docs entry for \"%s\" is empty", queryCommand)
+		replyPaged(c, c.T(
+			"help.main",
+			c.Parser.Command.Raw.Data, c.Parser.Command.Name.Data, strings.Join(prefixes, " "), c.Parser.Command.Raw.Data, categoryList(c),
+		))
+		return nil
+	}
+
+	query := make([]string, len(args))
+	for i, arg := range args {
+		query[i] = arg.Content.Data
+	}
+
+	// `help games` lists the category, `help games sawit` is the same as
+	// `help sawit`. A command named like a category (admin) is reached with
+	// `help admin admin` or through its subcommands.
+	if cat, ok := lookupCategory(query[0]); ok {
+		if len(query) == 1 {
+			replyPaged(c, categoryPage(c, cat))
 			return nil
 		}
+		if slices.Contains(helpIndex[cat], commandNames[query[1]]) {
+			query = query[1:]
+		} else if _, isCommand := mappedCommands[query[0]]; !isCommand {
+			c.QuoteReplyT("help.not_found", query[1])
+			return nil
+		}
+	}
 
-		pref := c.Parser.Command.UsedPrefix
-		SPACE := strings.Repeat(" ", SPACE_INDENT)
-
-		// NAME
-		var msg strings.Builder
-		fmt.Fprintf(&msg, "*NAME*\n%s%s\n\n", SPACE, commandMan.Name)
+	queryCommand := query[0]
+	foundCommand, ok := mappedCommands[queryCommand]
+	if !ok {
+		c.QuoteReplyT("help.not_found", queryCommand)
+		return nil
+	}
 
-		// SYNOPSIS
-		msg.WriteString("*SYNOPSIS*\n")
-		for _, s := range commandMan.Synopsis {
-			fmt.Fprintf(&msg, "%s%s%s\n", SPACE, pref, s)
+	parent := commandNames[queryCommand]
+	commandMan := foundCommand.Man
+	aliases := foundCommand.Aliases
+	subcommands := foundCommand.Subcommands
+	if len(query) > 1 && len(subcommands) > 0 {
+		querySub := query[1]
+		sub, ok := subcommands.Find(querySub)
+		if !ok {
+			c.QuoteReplyT("help.sub_not_found", querySub, queryCommand)
+			return nil
 		}
-		msg.WriteString("\n")
 
-		// ALIASES
-		if len(foundCommand.Aliases) > 0 {
-			msg.WriteString("*ALIASES*\n")
-			for _, a := range foundCommand.Aliases {
-				fmt.Fprintf(&msg, "%s%s%s\n", SPACE, pref, a)
-			}
-			msg.WriteString("\n")
-		}
+		commandMan = sub.Man
+		aliases = sub.fullAliases(parent)
+		subcommands = nil
+		queryCommand += " " + querySub
+	}
 
-		// DESCRIPTION
-		msg.WriteString("*DESCRIPTION*\n")
-		for _, d := range commandMan.Description {
-			d = strings.ReplaceAll(d, "\n", "\n"+SPACE)
-			d = strings.ReplaceAll(d, "{SPACE}", SPACE)
+	if len(commandMan.Name) == 0 {
+		c.QuoteReplyT("help.empty", queryCommand)
+		return nil
+	}
 
-			fmt.Fprintf(&msg, "%s%s\n\n", SPACE, d)
-		}
+	replyPaged(c, renderManual(c.Parser.Command.UsedPrefix, parent, commandMan, aliases, subcommands))
+	return nil
+}
 
-		// SOURCE CODE LINK
-		fmt.Fprintf(&msg, "*SOURCE CODE*\n%shttps://github.com/ziprawan/opc-kano/tree/rebase/internal/message/handles/%s\n\n", SPACE, commandMan.SourceFilename)
+func categoryTitle(c *messageutil.MessageContext, cat CommandCategory) string {
+	if cat == "" {
+		return c.T("help.category.other")
+	}
+	return c.T(i18n.MessageID("help.category." + string(cat)))
+}
 
-		// SEE ALSO
-		if len(commandMan.SeeAlso) > 0 {
-			msg.WriteString("*SEE ALSO*\n")
-			for idx, a := range commandMan.SeeAlso {
-				switch a.Type {
-				case SeeAlsoTypeCommand:
-					fmt.Fprintf(&msg, "%s%d. %s%s command\n", SPACE, idx+1, pref, a.Content)
-				case SeeAlsoTypeExternalLink:
-					fmt.Fprintf(&msg, "%s%d. Link: %s\n", SPACE, idx+1, a.Content)
-				}
-			}
+// Every category with its commands, for the main help message
+func categoryList(c *messageutil.MessageContext) string {
+	var msg strings.Builder
+	cats := Categories
+	if len(helpIndex[""]) > 0 {
+		cats = append(slices.Clip(cats), "")
+	}
+	for _, cat := range cats {
+		names := helpIndex[cat]
+		if len(names) == 0 {
+			continue
+		}
+		fmt.Fprintf(&msg, "\n*%s*", categoryTitle(c, cat))
+		if cat != "" {
+			fmt.Fprintf(&msg, " (`%s`)", cat)
 		}
+		fmt.Fprintf(&msg, "\n%s\n", strings.Join(names, ", "))
+	}
+	return msg.String()
+}
 
-		c.QuoteReply("%s", msg.String())
-		return nil
-	} else {
-		prefixes := make([]string, len(c.Parser.Prefixes))
-		for i, p := range c.Parser.Prefixes {
-			prefixes[i] = fmt.Sprintf("`%s`", p)
+// Commands of a category with their aliases and short description
+func categoryPage(c *messageutil.MessageContext, cat CommandCategory) string {
+	var list strings.Builder
+	for _, name := range helpIndex[cat] {
+		cmd := mappedCommands[name]
+		fmt.Fprintf(&list, "\n- *%s*", name)
+		if len(cmd.Aliases) > 0 {
+			fmt.Fprintf(&list, " (%s)", strings.Join(cmd.Aliases, ", "))
+		}
+		if _, desc, ok := strings.Cut(cmd.Man.Name, " - "); ok {
+			fmt.Fprintf(&list, "\n  %s", desc)
 		}
+	}
+	return c.T("help.category_page", categoryTitle(c, cat), list.String(), c.Parser.Command.Raw.Data)
+}
 
-		c.QuoteReply(
-			"Welcome to my most simple help message!\n_Tbh, I don't know how to design a help message, try `%s %s` for more info, I guess._\nCommand prefixes currently used include:\n%s\n\nBelow is a list of available commands:\n%s\nSource code bot: https://github.com/ziprawan/opc-kano",
-			c.Parser.Command.Raw.Data, c.Parser.Command.Name.Data, strings.Join(prefixes, " "), commandListStr,
-		)
+// Sends the page asked with page=N, the first one by default
+func replyPaged(c *messageutil.MessageContext, text string) {
+	pages := paginate(text, helpPageSize)
+	if len(pages) == 1 {
+		c.QuoteReply("%s", pages[0])
+		return
 	}
 
-	return nil
+	page, ok := argutil.Get[int64](c.Args, "page")
+	if !ok {
+		page = 1
+	}
+	if page < 1 || page > int64(len(pages)) {
+		c.QuoteReplyT("help.page_invalid", page, len(pages))
+		return
+	}
+
+	footer := c.T("help.page_last", page, len(pages))
+	if page < int64(len(pages)) {
+		query := []string{c.Parser.Command.Raw.Data}
+		for _, arg := range c.Parser.Args {
+			query = append(query, arg.Content.Data)
+		}
+		footer = c.T("help.page_next", page, len(pages), strings.Join(query, " "), page+1)
+	}
+	c.QuoteReply("%s\n\n%s", strings.TrimRight(pages[page-1], "\n"), footer)
+}
+
+// Splits text into pages of at most size bytes, preferably between
+// paragraphs, then between lines
+func paginate(text string, size int) []string {
+	pages := []string{}
+	for len(text) > size {
+		cut := strings.LastIndex(text[:size], "\n\n")
+		if cut <= 0 {
+			cut = strings.LastIndex(text[:size], "\n")
+		}
+		if cut <= 0 {
+			cut = size
+			for cut > 0 && !utf8.RuneStart(text[cut]) {
+				cut--
+			}
+		}
+		pages = append(pages, text[:cut])
+		text = strings.TrimLeft(text[cut:], "\n")
+	}
+	return append(pages, text)
 }
 
 var HelpMan = CommandMan{
 	Name: "help - a simple command reference manuals",
 	Synopsis: []string{
-		"*help* [ _command_ ]",
+		"*help* [ *page=*_number_ ]",
+		"*help* _category_ [ *page=*_number_ ]",
+		"*help* [ _category_ ] _command_ [ _subcommand_ ] [ *page=*_number_ ]",
 	},
 	Description: []string{
 		"Help is a very simple command reference manual, with the entire help functionality inspired by the manpage.",
@@ -113,7 +246,10 @@ var HelpMan = CommandMan{
 			"\n- Named argument: an argument type that pairs a key and value with an equals sign (=). If the value contains at least one space, enclose and enclose the argument without quotation marks." +
 			"\n- Space: a character that visually separates the characters mediated by it. The characters defined as spaces are SPACE (U+0020), LINE FEED (U+000A), CARRIAGE RETURN (U+000D), CHARACTER TABULATION (U+0009), LINE TABULATION (U+000B)." +
 			"\n- Quotation marks: punctuation marks used in pairs to identify direct speech, quotations, or phrases, or in this context, to identify spaced arguments. The characters defined as quotation marks are as defined in the following source code: https://github.com/ziprawan/opc-kano/blob/rebase/internal/utils/word/isquote.go#L8-L17",
-		"Conventional section names include *NAME*, *SYNOPSIS*, *ALIASES*, *DESCRIPTION*, *SOURCE CODE*, and *SEE ALSO*.",
+		"Conventional section names include *NAME*, *SYNOPSIS*, *ALIASES*, *DESCRIPTION*, *SUBCOMMANDS*, *SOURCE CODE*, and *SEE ALSO*.",
+		"Commands with subcommands (e.g. `six` or `sawit`) have a manual for each subcommand too. Give the subcommand name after the command name to see it.",
+		"Commands are grouped into categories: `academic`, `games`, `media`, `utilities` and `admin`. Give a category name to list its commands with a short description. A command can also be given after its category, e.g. `help admin admin` for the manual of the `admin` command.",
+		"Long manuals are split into pages. Add `page=2` to see the second page, and so on.",
 		"The following conventions apply to the *SYNOPSIS* section and can be used as a guide in other sections.",
 		"*bold text* - type exactly as shown." +
 			"\n_italic text_ - replace with appropriate argument." +
@@ -129,3 +265,70 @@ var HelpMan = CommandMan{
 		{"man", SeeAlsoTypeCommand},
 	},
 }
+
+// Renders the manual in manpage-like sections. parent is the command name
+// used to list the subcommands, if any.
+func renderManual(pref, parent string, commandMan CommandMan, aliases []string, subcommands SubcommandList) string {
+	SPACE := strings.Repeat(" ", SPACE_INDENT)
+
+	// NAME
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "*NAME*\n%s%s\n\n", SPACE, commandMan.Name)
+
+	// SYNOPSIS
+	msg.WriteString("*SYNOPSIS*\n")
+	for _, s := range commandMan.Synopsis {
+		fmt.Fprintf(&msg, "%s%s%s\n", SPACE, pref, s)
+	}
+	msg.WriteString("\n")
+
+	// ALIASES
+	if len(aliases) > 0 {
+		msg.WriteString("*ALIASES*\n")
+		for _, a := range aliases {
+			fmt.Fprintf(&msg, "%s%s%s\n", SPACE, pref, a)
+		}
+		msg.WriteString("\n")
+	}
+
+	// DESCRIPTION
+	msg.WriteString("*DESCRIPTION*\n")
+	for _, d := range commandMan.Description {
+		d = strings.ReplaceAll(d, "\n", "\n"+SPACE)
+		d = strings.ReplaceAll(d, "{SPACE}", SPACE)
+
+		fmt.Fprintf(&msg, "%s%s\n\n", SPACE, d)
+	}
+
+	// SUBCOMMANDS
+	if len(subcommands) > 0 {
+		msg.WriteString("*SUBCOMMANDS*\n")
+		for _, sub := range subcommands {
+			names := append([]string{sub.Name}, sub.Aliases...)
+			fmt.Fprintf(&msg, "%s*%s*\n", SPACE, strings.Join(names, "*|*"))
+			// Strip the "name - " part, leaving the short description
+			if _, desc, ok := strings.Cut(sub.Man.Name, " - "); ok {
+				fmt.Fprintf(&msg, "%s%s%s\n", SPACE, SPACE, desc)
+			}
+		}
+		fmt.Fprintf(&msg, "%sUse `%shelp %s _subcommand_` for the subcommand manual.\n\n", SPACE, pref, parent)
+	}
+
+	// SOURCE CODE LINK
+	fmt.Fprintf(&msg, "*SOURCE CODE*\n%shttps://github.com/ziprawan/opc-kano/tree/rebase/internal/message/handles/%s\n\n", SPACE, commandMan.SourceFilename)
+
+	// SEE ALSO
+	if len(commandMan.SeeAlso) > 0 {
+		msg.WriteString("*SEE ALSO*\n")
+		for idx, a := range commandMan.SeeAlso {
+			switch a.Type {
+			case SeeAlsoTypeCommand:
+				fmt.Fprintf(&msg, "%s%d. %s%s command\n", SPACE, idx+1, pref, a.Content)
+			case SeeAlsoTypeExternalLink:
+				fmt.Fprintf(&msg, "%s%d. Link: %s\n", SPACE, idx+1, a.Content)
+			}
+		}
+	}
+
+	return msg.String()
+}
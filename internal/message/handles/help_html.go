@@ -0,0 +1,211 @@
+package handles
+
+import (
+	"html"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Manuals read badly on small screens, so they can be exported as a single
+// static page. Prefixes are left out since they differ per chat.
+
+type helpPageCommand struct {
+	Name        string
+	Aliases     []string
+	Man         CommandMan
+	Subcommands []helpPageCommand
+}
+
+type helpPageCategory struct {
+	Key      CommandCategory
+	Title    string
+	Commands []helpPageCommand
+}
+
+var categoryTitles = map[CommandCategory]string{
+	CategoryAcademic:  "Academic",
+	CategoryGames:     "Games",
+	CategoryMedia:     "Media",
+	CategoryUtilities: "Utilities",
+	CategoryAdmin:     "Administration",
+	"":                "Other",
+}
+
+var helpPageTemplate = template.Must(template.New("help").Funcs(template.FuncMap{
+	"format": formatManText,
+	"anchor": func(name string) string { return strings.ReplaceAll(name, " ", "-") },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Kano command manuals</title>
+<style>
+body { font-family: sans-serif; max-width: 52rem; margin: 0 auto; padding: 1rem; line-height: 1.5; }
+h3 { margin-bottom: 0; }
+.section { font-weight: bold; margin-top: 1rem; }
+.text { white-space: pre-wrap; margin-left: 1.5rem; }
+.sub { margin-left: 1.5rem; border-left: 2px solid #ddd; padding-left: 1rem; }
+code { background: #f2f2f2; padding: 0 .2rem; }
+</style>
+</head>
+<body>
+<h1>Kano command manuals</h1>
+<ul>
+{{- range .}}
+<li><a href="#{{.Key}}">{{.Title}}</a>: {{range $i, $c := .Commands}}{{if $i}}, {{end}}<a href="#{{anchor $c.Name}}">{{$c.Name}}</a>{{end}}</li>
+{{- end}}
+</ul>
+{{- define "manual"}}
+<h3 id="{{anchor .Name}}">{{.Name}}</h3>
+{{- if .Man.Name}}
+<div class="section">NAME</div>
+<div class="text">{{.Man.Name}}</div>
+<div class="section">SYNOPSIS</div>
+<div class="text">{{range .Man.Synopsis}}{{format .}}
+{{end}}</div>
+{{- if .Aliases}}
+<div class="section">ALIASES</div>
+<div class="text">{{range .Aliases}}{{.}}
+{{end}}</div>
+{{- end}}
+<div class="section">DESCRIPTION</div>
+{{- range .Man.Description}}
+<p class="text">{{format .}}</p>
+{{- end}}
+{{- if .Man.SeeAlso}}
+<div class="section">SEE ALSO</div>
+<div class="text">
+{{- range .Man.SeeAlso}}
+{{- if eq .Type "command"}}<a href="#{{anchor .Content}}">{{.Content}}</a>{{else}}<a href="{{.Content}}">{{.Content}}</a>{{end}}
+{{end}}</div>
+{{- end}}
+<div class="section">SOURCE CODE</div>
+<div class="text"><a href="https://github.com/ziprawan/opc-kano/tree/rebase/internal/message/handles/{{.Man.SourceFilename}}">{{.Man.SourceFilename}}</a></div>
+{{- else}}
+<p class="text">No manual yet.</p>
+{{- end}}
+{{- if .Subcommands}}
+<div class="sub">
+{{- range .Subcommands}}{{template "manual" .}}{{end}}
+</div>
+{{- end}}
+{{- end}}
+{{- range .}}
+<h2 id="{{.Key}}">{{.Title}}</h2>
+{{- range .Commands}}{{template "manual" .}}{{end}}
+{{- end}}
+</body>
+</html>
+`))
+
+func helpPage() []helpPageCategory {
+	cats := append(Categories[:len(Categories):len(Categories)], "")
+
+	res := []helpPageCategory{}
+	for _, cat := range cats {
+		names := helpIndex[cat]
+		if len(names) == 0 {
+			continue
+		}
+
+		page := helpPageCategory{Key: cat, Title: categoryTitles[cat]}
+		if cat == "" {
+			page.Key = "other"
+		}
+		for _, name := range names {
+			cmd := mappedCommands[name]
+			entry := helpPageCommand{Name: name, Aliases: cmd.Aliases, Man: cmd.Man}
+			for _, sub := range cmd.Subcommands {
+				entry.Subcommands = append(entry.Subcommands, helpPageCommand{
+					Name:    name + " " + sub.Name,
+					Aliases: sub.fullAliases(name),
+					Man:     sub.Man,
+				})
+			}
+			page.Commands = append(page.Commands, entry)
+		}
+		res = append(res, page)
+	}
+	return res
+}
+
+// Writes every command manual as a standalone HTML page
+func ExportHelpHTML(w io.Writer) error {
+	return helpPageTemplate.Execute(w, helpPage())
+}
+
+func ExportHelpFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := ExportHelpHTML(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Turns WhatsApp formatting (*bold*, _italic_ and `code`) into HTML
+func formatManText(s string) template.HTML {
+	s = strings.ReplaceAll(s, "{SPACE}", "    ")
+	return template.HTML(formatInline(html.EscapeString(s)))
+}
+
+var inlineTags = map[byte]string{'*': "b", '_': "i", '`': "code"}
+
+func formatInline(s string) string {
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		tag, ok := inlineTags[s[i]]
+		if !ok || !isMarkBoundary(s, i-1) {
+			out.WriteByte(s[i])
+			continue
+		}
+
+		end := closingMark(s, i)
+		if end == -1 {
+			out.WriteByte(s[i])
+			continue
+		}
+
+		inner := s[i+1 : end]
+		if tag != "code" {
+			inner = formatInline(inner)
+		}
+		out.WriteString("<" + tag + ">" + inner + "</" + tag + ">")
+		i = end
+	}
+	return out.String()
+}
+
+// Marks only open or close next to spaces and punctuation, so pack_name
+// stays as is
+func isMarkBoundary(s string, i int) bool {
+	if i < 0 || i >= len(s) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	if !utf8.RuneStart(s[i]) {
+		r, _ = utf8.DecodeLastRuneInString(s[:i+1])
+	}
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func closingMark(s string, open int) int {
+	mark := s[open]
+	for j := open + 2; j < len(s); j++ {
+		if s[j] == '\n' {
+			return -1
+		}
+		if s[j] == mark && s[j-1] != ' ' && isMarkBoundary(s, j+1) {
+			return j
+		}
+	}
+	return -1
+}
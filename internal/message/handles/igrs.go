@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"kano/internal/config"
+	"kano/internal/utils/httpclient"
 	"kano/internal/utils/messageutil"
 	"kano/internal/utils/word"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -42,6 +44,49 @@ type rgsiResponse struct {
 	Embedded rgsiGameList `json:"_embedded"`
 }
 
+// Retries are left to the client, the API often answers 504 under load
+var rgsiClient = httpclient.New(httpclient.Options{
+	Timeout:      time.Minute,
+	MaxRetries:   4,
+	BaseDelay:    2 * time.Second,
+	MaxDelay:     20 * time.Second,
+	MaxBodyBytes: 8 << 20,
+	PerHost:      2,
+})
+
+// Decodes the JSON response into v. Replies to the user and returns false if
+// that didn't work out.
+func rgsiGet(c *messageutil.MessageContext, u string, v any) (bool, error) {
+	req, err := http.NewRequestWithContext(c.Context(), "GET", u, nil)
+	if err != nil {
+		c.QuoteReplyT("igrs.request_failed")
+		return false, err
+	}
+	req.Header.Set("User-Agent", config.GetConfig().UserAgent)
+
+	notice := time.AfterFunc(10*time.Second, func() {
+		c.QuoteReplyT("igrs.slow")
+	})
+	resp, err := rgsiClient.Do(req)
+	notice.Stop()
+	if err != nil {
+		c.QuoteReplyT("igrs.fetch_failed", err)
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		c.QuoteReplyT("igrs.api_status", resp.Status)
+		return false, nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		c.QuoteReplyT("igrs.decode_failed", err)
+		return false, err
+	}
+	return true, nil
+}
+
 func rgsiApi() string {
 	return "https://" + word.Reverse(word.FromBase64("c2VtYWcvY2lsYnVwL2RpLnNyZ2kuaXBh"))
 }
@@ -49,138 +94,64 @@ func rgsiApi() string {
 func Rgsi(c *messageutil.MessageContext) error {
 	args := c.Parser.RawArg.Content.Data
 	if len(args) == 0 {
-		c.QuoteReply("Give the game name")
+		c.QuoteReplyT("igrs.no_name")
 		return nil
 	}
 
-	maxRetries := 5
-
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		req, err := http.NewRequest("GET", rgsiApi(), nil)
-		if err != nil {
-			c.QuoteReply("Internal error while creating request object")
-			return err
-		}
-
-		req.Header.Set("User-Agent", config.USER_AGENT)
-
-		q := req.URL.Query()
-		q.Set("nameLike", args)
-		q.Set("page", "0")
-		q.Set("size", "1000")
-		req.URL.RawQuery = q.Encode()
-
-		cli := http.Client{}
-
-		type fresult struct {
-			resp *http.Response
-			err  error
-		}
-
-		resCh := make(chan fresult)
-
-		go func() {
-			resp, err := cli.Do(req)
-			resCh <- fresult{resp: resp, err: err}
-		}()
-
-		var resp *http.Response
-
-		select {
-		case res := <-resCh:
-			resp = res.resp
-			err = res.err
-		case <-time.After(10 * time.Second):
-			c.QuoteReply("It seems the request is taking longer than expected, please wait")
-			res := <-resCh
-			resp = res.resp
-			err = res.err
-		}
-
-		// ---- check result ----
-		if err != nil {
-			if attempt == maxRetries {
-				c.QuoteReply("Request failed after %d attempts: %v", attempt, err)
-				return err
-			}
-			if resp != nil {
-				resp.Body.Close()
-			}
-			c.QuoteReply("Request failed, retrying... (%d/5)", attempt)
-			continue // retry
-		}
-
-		if resp.StatusCode == 504 {
-			if attempt == maxRetries {
-				c.QuoteReply("API keeps returning 504 after %d attempts", attempt)
-				return nil
-			}
-			if resp != nil {
-				resp.Body.Close()
-			}
-			c.QuoteReply("Request failed, retrying... (%d/5)", attempt)
-			continue // retry
-		}
-
-		if resp.StatusCode > 299 {
-			c.QuoteReply("API returned %s", resp.Status)
-			return nil
-		}
+	q := url.Values{}
+	q.Set("nameLike", args)
+	q.Set("page", "0")
+	q.Set("size", "1000")
 
-		// success
-		var jsResp rgsiResponse
-		err = json.NewDecoder(resp.Body).Decode(&jsResp)
-		if err != nil {
-			c.QuoteReply("Internal error while reading the response with error %s", err)
-			return err
-		}
+	var jsResp rgsiResponse
+	if ok, err := rgsiGet(c, rgsiApi()+"?"+q.Encode(), &jsResp); !ok {
+		return err
+	}
 
-		result := jsResp.Embedded.Result
-		if len(result) == 0 {
-			c.QuoteReply("Game not found")
-			return nil
-		}
+	result := jsResp.Embedded.Result
+	if len(result) == 0 {
+		c.QuoteReplyT("igrs.not_found")
+		return nil
+	}
 
-		infoStrs := make([]string, len(result))
-		for i, game := range result {
-			var builder strings.Builder
-
-			fmt.Fprintf(&builder, "*%s - %s*\n", game.Name, game.Publisher)
-			fmt.Fprintf(&builder, "> %s\n", strings.ReplaceAll(game.Description, "\n", ""))
-			fmt.Fprintf(&builder, "Platforms: %s\n", strings.Join(game.Platforms, ", "))
-
-			if len(game.Ratings) > 0 {
-				ratings := make([]string, len(game.Ratings))
-				for j, rating := range game.Ratings {
-					if !rating.Enabled {
-						ratings[j] = fmt.Sprintf("~%s~", rating.Name)
-					} else {
-						ratings[j] = rating.Name
-					}
+	infoStrs := make([]string, len(result))
+	for i, game := range result {
+		var builder strings.Builder
+
+		fmt.Fprintf(&builder, "*%s - %s*\n", game.Name, game.Publisher)
+		fmt.Fprintf(&builder, "> %s\n", strings.ReplaceAll(game.Description, "\n", ""))
+		fmt.Fprintf(&builder, "Platforms: %s\n", strings.Join(game.Platforms, ", "))
+
+		if len(game.Ratings) > 0 {
+			ratings := make([]string, len(game.Ratings))
+			for j, rating := range game.Ratings {
+				if !rating.Enabled {
+					ratings[j] = fmt.Sprintf("~%s~", rating.Name)
+				} else {
+					ratings[j] = rating.Name
 				}
-				fmt.Fprintf(&builder, "*Ratings: %s*\n", strings.Join(ratings, ", "))
 			}
+			fmt.Fprintf(&builder, "*Ratings: %s*\n", strings.Join(ratings, ", "))
+		}
 
-			if len(game.Descriptors) > 0 {
-				descriptors := make([]string, len(game.Descriptors))
-				for j, desc := range game.Descriptors {
-					if !desc.Enabled {
-						descriptors[j] = fmt.Sprintf("- ~%s~", desc.Name)
-					} else {
-						descriptors[j] = fmt.Sprintf("- %s", desc.Name)
-					}
+		if len(game.Descriptors) > 0 {
+			descriptors := make([]string, len(game.Descriptors))
+			for j, desc := range game.Descriptors {
+				if !desc.Enabled {
+					descriptors[j] = fmt.Sprintf("- ~%s~", desc.Name)
+				} else {
+					descriptors[j] = fmt.Sprintf("- %s", desc.Name)
 				}
-				fmt.Fprintf(&builder, "*Descriptors:*\n%s\n", strings.Join(descriptors, "\n"))
 			}
-
-			fmt.Fprintf(&builder, "Related URLs: %s - %s\n", game.InGameUrl, game.VideoUrl)
-
-			infoStrs[i] = builder.String()
+			fmt.Fprintf(&builder, "*Descriptors:*\n%s\n", strings.Join(descriptors, "\n"))
 		}
 
-		c.QuoteReply("%s", strings.Join(infoStrs, "\n====\n\n"))
-		break
+		fmt.Fprintf(&builder, "Related URLs: %s - %s\n", game.InGameUrl, game.VideoUrl)
+
+		infoStrs[i] = builder.String()
 	}
 
+	c.QuoteReply("%s", strings.Join(infoStrs, "\n====\n\n"))
+
 	return nil
 }
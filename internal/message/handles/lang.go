@@ -0,0 +1,149 @@
+package handles
+
+import (
+	"fmt"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/chatutil/grouputil"
+	"kano/internal/utils/i18n"
+	"kano/internal/utils/messageutil"
+	"strings"
+)
+
+var langSubcommands = SubcommandList{
+	{
+		Name:       "set",
+		Func:       langSet,
+		Man:        LangSetMan,
+		Permission: PermissionGroupAdmin,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "language", Type: argutil.TypeString, Required: true},
+			},
+		},
+	},
+	{
+		Name:       "reset",
+		Func:       langReset,
+		Man:        LangResetMan,
+		Permission: PermissionGroupAdmin,
+	},
+}
+
+// Only reached when no subcommand matches
+func LangHandler(c *messageutil.MessageContext) error {
+	if len(c.Parser.Args) > 0 {
+		c.QuoteReplyT("lang.invalid_sub", c.Parser.Args[0].Content.Data, c.Parser.Command.UsedPrefix)
+		return nil
+	}
+
+	mark := ""
+	if chatLang(c) == "" {
+		mark = c.T("lang.default_mark")
+	}
+	c.QuoteReplyT("lang.current", c.Lang(), mark, availableLangs())
+	return nil
+}
+
+func langSet(c *messageutil.MessageContext) error {
+	val, _ := argutil.Get[string](c.Args, "language")
+	lang, ok := i18n.Parse(val)
+	if !ok {
+		c.QuoteReplyT("lang.invalid", val, availableLangs())
+		return nil
+	}
+	return saveLang(c, string(lang))
+}
+
+func langReset(c *messageutil.MessageContext) error {
+	return saveLang(c, "")
+}
+
+// Group language in groups, the sender's own language in private chats
+func chatLang(c *messageutil.MessageContext) string {
+	if isGroupChat(c) {
+		// Without a settings row the group uses the default
+		if c.Group == nil || c.Group.GroupSettings == nil {
+			return ""
+		}
+		return c.Group.GroupSettings.Language
+	}
+	if c.Contact == nil {
+		return ""
+	}
+	return c.Contact.Language
+}
+
+func saveLang(c *messageutil.MessageContext, lang string) error {
+	var err error
+	if isGroupChat(c) {
+		var settings *grouputil.GroupSettings
+		if settings, err = groupSettings(c); err == nil {
+			settings.Language = lang
+			err = settings.Save()
+		}
+	} else if c.Contact == nil {
+		err = fmt.Errorf("contact info is unavailable")
+	} else {
+		c.Contact.Language = lang
+		err = c.Contact.Save()
+	}
+	if err != nil {
+		c.QuoteReplyT("lang.save_failed", err)
+		return err
+	}
+
+	c.QuoteReplyT("lang.saved", c.Lang())
+	return nil
+}
+
+func availableLangs() string {
+	langs := i18n.Langs()
+	names := make([]string, len(langs))
+	for i, l := range langs {
+		names[i] = fmt.Sprintf("`%s`", l)
+	}
+	return strings.Join(names, " ")
+}
+
+var LangMan = CommandMan{
+	Name: "lang - set the bot language",
+	Synopsis: []string{
+		"*lang*",
+		"*lang* *set* _language_",
+		"*lang* *reset*",
+	},
+	Description: []string{
+		"Shows or changes the language the bot replies in. In group chats the language applies to everyone in the group and can only be changed by group admins, in private chats it applies to you. " +
+			"A group language takes precedence over the personal one.",
+		"Without arguments, shows the current language.",
+	},
+	SourceFilename: "lang.go",
+	SeeAlso: []SeeAlso{
+		{"prefix", SeeAlsoTypeCommand},
+	},
+}
+
+var LangSetMan = CommandMan{
+	Name: "lang set - change the language",
+	Synopsis: []string{
+		"*lang* *set* _language_",
+	},
+	Description: []string{
+		"_language_" +
+			"\n{SPACE}Either `id` (Indonesian) or `en` (English).",
+	},
+	SourceFilename: "lang.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var LangResetMan = CommandMan{
+	Name: "lang reset - go back to the default language",
+	Synopsis: []string{
+		"*lang* *reset*",
+	},
+	Description: []string{
+		"Removes the language preference of this chat, so the default one (Indonesian) is used again.",
+	},
+	SourceFilename: "lang.go",
+	SeeAlso:        []SeeAlso{},
+}
@@ -2,11 +2,13 @@ package handles
 
 import (
 	"errors"
-	"fmt"
 	"kano/internal/config"
 	"kano/internal/database"
+	"kano/internal/utils/argutil"
 	"kano/internal/utils/messageutil"
-	"strings"
+	"kano/internal/utils/ratelimit"
+	"kano/internal/utils/usage"
+	"time"
 )
 
 var ErrNotImplemented = errors.New("command not implemented")
@@ -15,91 +17,185 @@ type CommandHandlerFuncMap map[string]CommandHandler
 
 var HANDLES CommandHandlerFuncMap = CommandHandlerFuncMap{
 	"ping": CommandHandler{
-		Func:    Ping,
-		Aliases: []string{"p"},
-		Man:     PingMan,
+		Func:     Ping,
+		Aliases:  []string{"p"},
+		Man:      PingMan,
+		Category: CategoryUtilities,
 	},
 	"stk": CommandHandler{
-		Func:    Stk,
-		Aliases: []string{"s"},
-		Man:     StkMan,
+		Func:          Stk,
+		Aliases:       []string{"s"},
+		Man:           StkMan,
+		Category:      CategoryMedia,
+		UserRateLimit: ratelimit.Rule{Limit: 5, Window: time.Minute},
+		Feature:       FeatureSticker,
 	},
 	"vo": CommandHandler{
-		Func:    Vo,
-		Aliases: []string{"v"},
-		Man:     VoMan,
+		Func:          Vo,
+		Aliases:       []string{"v"},
+		Man:           VoMan,
+		Category:      CategoryMedia,
+		UserRateLimit: ratelimit.Rule{Limit: 5, Window: time.Minute},
+		Feature:       FeatureViewOnce,
 	},
 	"nim": CommandHandler{
-		Func: Nim,
-		Man:  NimHelp,
+		Func:     Nim,
+		Man:      NimHelp,
+		Category: CategoryAcademic,
 	},
 	"pddikti": CommandHandler{
-		Func:    Pddikti,
-		Aliases: []string{"diddy"},
-		Man:     PddiktiMan,
+		Func:           Pddikti,
+		Aliases:        []string{"diddy"},
+		Man:            PddiktiMan,
+		Category:       CategoryAcademic,
+		UserRateLimit:  ratelimit.Rule{Limit: 3, Window: time.Minute},
+		GroupRateLimit: ratelimit.Rule{Limit: 10, Window: time.Minute},
 	},
 	"confess": CommandHandler{
-		Func:    Confess,
-		Aliases: []string{"c"},
+		Func:     Confess,
+		Aliases:  []string{"c"},
+		Man:      ConfessMan,
+		Category: CategoryUtilities,
+		Scope:    ScopePrivate,
 	},
 	"confesstarget": CommandHandler{
-		Func:    ConfessTargetHandler,
-		Aliases: []string{"ct"},
+		Func:     ConfessTargetHandler,
+		Aliases:  []string{"ct"},
+		Man:      ConfessTargetMan,
+		Category: CategoryUtilities,
+		Scope:    ScopePrivate,
 	},
 	"six": CommandHandler{
-		Func: Six,
-		Man:  SixMan,
+		Func:        Six,
+		Man:         SixMan,
+		Category:    CategoryAcademic,
+		Subcommands: sixSubcommands,
 	},
 	"ta": CommandHandler{
-		Func: Ta,
-		Man:  TaMan,
+		Func:          Ta,
+		Man:           TaMan,
+		Category:      CategoryUtilities,
+		UserRateLimit: ratelimit.Rule{Limit: 3, Window: time.Minute},
+		Feature:       FeatureTa,
 	},
 	"test": CommandHandler{
-		Func: Test,
-		Man:  TestMan,
+		Func:       Test,
+		Man:        TestMan,
+		Category:   CategoryAdmin,
+		Permission: PermissionOwner,
 	},
 	"redirect": CommandHandler{
-		Func:    Redirect,
-		Aliases: []string{"r", "getredir", "getloc"},
-		Man:     RedirectMan,
+		Func:          Redirect,
+		Aliases:       []string{"r", "getredir", "getloc"},
+		Man:           RedirectMan,
+		Category:      CategoryUtilities,
+		UserRateLimit: ratelimit.Rule{Limit: 5, Window: time.Minute},
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "url", Type: argutil.TypeURL, Required: true},
+			},
+		},
 	},
 	"resolve-subject": CommandHandler{
-		Func:    ResolveSubject,
-		Aliases: []string{"rs"},
-		Man:     ResolveSubjectMan,
+		Func:       ResolveSubject,
+		Aliases:    []string{"rs"},
+		Man:        ResolveSubjectMan,
+		Category:   CategoryAdmin,
+		Permission: PermissionOwner,
 	},
 	"wordle": CommandHandler{
-		Func:    WordleHandler,
-		Aliases: []string{"worlde", "w"},
-		Man:     WordleMan,
+		Func:     WordleHandler,
+		Aliases:  []string{"worlde", "w"},
+		Man:      WordleMan,
+		Category: CategoryGames,
+		Feature:  FeatureGame,
 	},
 	"sawit": CommandHandler{
-		Func: SawitHandler,
-		Man:  SawitMan,
+		Func:        SawitHandler,
+		Man:         SawitMan,
+		Category:    CategoryGames,
+		Subcommands: sawitSubcommands,
+		Scope:       ScopeGroup,
+		Feature:     FeatureGame,
 	},
 	"game": CommandHandler{
-		Func: GameHandler,
-		Man:  GameMan,
+		Func:       GameHandler,
+		Man:        GameMan,
+		Category:   CategoryGames,
+		Permission: PermissionGroupAdmin,
+		Scope:      ScopeGroup,
 	},
 	"help": CommandHandler{
-		Func:    HelpHandler,
-		Aliases: []string{"man"},
-		Man:     HelpMan,
+		Func:     HelpHandler,
+		Aliases:  []string{"man"},
+		Man:      HelpMan,
+		Category: CategoryUtilities,
+		Args: argutil.Schema{
+			Named: []argutil.Spec{
+				{Name: "page", Type: argutil.TypeInt},
+			},
+		},
 	},
 	"enable": CommandHandler{
-		Func:    EnableHandler,
-		Aliases: []string{"disable"},
-		Man:     EnableMan,
+		Func:       EnableHandler,
+		Aliases:    []string{"disable"},
+		Man:        EnableMan,
+		Category:   CategoryAdmin,
+		Permission: PermissionGroupAdmin,
+		Scope:      ScopeGroup,
 	},
 	"stkline": CommandHandler{
-		Func: StkLineHandler,
+		Func:           StkLineHandler,
+		Man:            StkLineMan,
+		Category:       CategoryMedia,
+		UserRateLimit:  ratelimit.Rule{Limit: 1, Window: 30 * time.Second},
+		GroupRateLimit: ratelimit.Rule{Limit: 3, Window: time.Minute},
 	},
 	"download": CommandHandler{
-		Func:    DownloadHandler,
-		Aliases: []string{"down"},
+		Func:           DownloadHandler,
+		Aliases:        []string{"down"},
+		Man:            DownloadMan,
+		Category:       CategoryMedia,
+		UserRateLimit:  ratelimit.Rule{Limit: 1, Window: 30 * time.Second},
+		GroupRateLimit: ratelimit.Rule{Limit: 3, Window: time.Minute},
+		Feature:        FeatureDownload,
 	},
 	"rg": CommandHandler{
-		Func: RefreshGroups,
+		Func:       RefreshGroups,
+		Man:        AdminRefreshMan,
+		Category:   CategoryAdmin,
+		Permission: PermissionOwner,
+	},
+	"admin": CommandHandler{
+		Func:        AdminHandler,
+		Man:         AdminMan,
+		Category:    CategoryAdmin,
+		Permission:  PermissionOwner,
+		Subcommands: adminSubcommands,
+	},
+	"prefix": CommandHandler{
+		Func:        PrefixHandler,
+		Man:         PrefixMan,
+		Category:    CategoryAdmin,
+		Subcommands: prefixSubcommands,
+	},
+	"lang": CommandHandler{
+		Func:        LangHandler,
+		Aliases:     []string{"language", "bahasa"},
+		Man:         LangMan,
+		Category:    CategoryUtilities,
+		Subcommands: langSubcommands,
+	},
+	"stats": CommandHandler{
+		Func:       StatsHandler,
+		Man:        StatsMan,
+		Category:   CategoryAdmin,
+		Permission: PermissionOwner,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "window", Type: argutil.TypeDuration},
+			},
+		},
 	},
 
 	// "jadwal": CommandHandler{
@@ -113,6 +209,7 @@ var HANDLES CommandHandlerFuncMap = CommandHandlerFuncMap{
 }
 
 var mappedCommands map[string]CommandHandler = map[string]CommandHandler{}
+var commandNames map[string]string = map[string]string{} // alias -> HANDLES key
 var db = database.GetInstance()
 
 func init() {
@@ -122,36 +219,61 @@ func init() {
 	if len(mappedCommands) == 0 {
 		for key, val := range HANDLES {
 			mappedCommands[key] = val
+			commandNames[key] = key
 			for _, alias := range val.Aliases {
 				mappedCommands[alias] = val
+				commandNames[alias] = key
 			}
 		}
 
-		// Command list string for help message
-		var msg strings.Builder
-		for cmd := range HANDLES {
-			msg.WriteString("- " + cmd)
-			aliases := HANDLES[cmd].Aliases
-			if len(aliases) > 0 {
-				fmt.Fprintf(&msg, " (%s)", strings.Join(aliases, ", "))
-			}
-			msg.WriteString("\n")
-		}
-
-		commandListStr = msg.String()
+		indexHelp()
+		checkManuals(log)
+		checkFeatureDefaults(log)
 	}
 }
 
-func Handle(c *messageutil.MessageContext) error {
+func Handle(c *messageutil.MessageContext) (err error) {
 	cmd := c.Parser.Command.Name.Data
 	c.Logger.Debugf("Got command: %s", cmd)
 
 	detectedFunc, ok := mappedCommands[cmd]
-	if ok {
-		c.Logger.Debugf("Command handler found")
-		return detectedFunc.Func(c)
+	if !ok {
+		c.Logger.Debugf("Command handler not found, ignore the command")
+		replySuggestion(c, cmd)
+		return nil
 	}
 
-	c.Logger.Debugf("Command handler not found, ignore the command")
-	return nil
+	c.Logger = c.Logger.WithCommand(commandNames[cmd])
+	c.Logger.Debugf("Command handler found")
+	entry := usage.Start(c, commandNames[cmd], cmd)
+	// Stays panic if run never returns, the panic itself is recovered upstream
+	outcome := usage.OutcomePanic
+	defer func() { entry.Finish(outcome, err) }()
+
+	outcome, err = run(c, detectedFunc, entry)
+	return err
+}
+
+func run(c *messageutil.MessageContext, h CommandHandler, entry *usage.Entry) (usage.Outcome, error) {
+	if !h.checkAccess(c) {
+		return usage.OutcomeDenied, nil
+	}
+	if !h.checkRateLimit(c, entry.Command) {
+		return usage.OutcomeRateLimited, nil
+	}
+	if sub, ok := h.findSubcommand(c); ok {
+		c.Logger.Debugf("Subcommand %s found", sub.Name)
+		entry.Subcommand = sub.Name
+		if !sub.checkAccess(c) {
+			return usage.OutcomeDenied, nil
+		}
+		if !validateArgs(c, sub.Args, 1, sub.Man) {
+			return usage.OutcomeInvalidArgs, nil
+		}
+		return usage.OutcomeOK, sub.Func(c)
+	}
+	if !validateArgs(c, h.Args, 0, h.Man) {
+		return usage.OutcomeInvalidArgs, nil
+	}
+	return usage.OutcomeOK, h.Func(c)
 }
@@ -1,7 +1,7 @@
 package handles
 
 import (
-	"fmt"
+	"context"
 	"kano/internal/database"
 	"kano/internal/database/models"
 	"kano/internal/utils/messageutil"
@@ -33,8 +33,8 @@ type query struct {
 	name  string
 }
 
-func searchStudents(queries []query) ([]models.Student, error) {
-	db := database.GetInstance()
+func searchStudents(ctx context.Context, queries []query) ([]models.Student, error) {
+	db := database.GetInstance().WithContext(ctx)
 	db = db.Debug()
 
 	if len(queries) == 0 {
@@ -188,21 +188,21 @@ func Nim(c *messageutil.MessageContext) error {
 	reset()
 
 	qStartTime := time.Now().UnixMilli()
-	founds, err := searchStudents(queries)
+	founds, err := searchStudents(c.Context(), queries)
 	qDiffTime := time.Now().UnixMilli() - qStartTime
 	if err != nil {
-		c.QuoteReply("Failed to query to database: %s\nQuery time: %d ms", err.Error(), qDiffTime)
+		c.QuoteReplyT("nim.query_failed", err.Error(), qDiffTime)
 		return nil
 	}
 
 	var builtStr strings.Builder
-	fmt.Fprintf(&builtStr, "Found %d students\nQuery time: %d ms", len(founds), qDiffTime)
+	builtStr.WriteString(c.T("nim.found", len(founds), qDiffTime))
 
 	for i, student := range founds {
 		if i > 100 {
 			break
 		}
-		fmt.Fprintf(&builtStr, "\n=====\nName: %s\nNIM: %d\nMajor - Faculty: %s - %s", student.Name, student.Nim, student.Major, student.Faculty)
+		builtStr.WriteString("\n=====\n" + c.T("nim.entry", student.Name, student.Nim, student.Major, student.Faculty))
 	}
 
 	c.QuoteReply("%s", builtStr.String())
@@ -12,18 +12,18 @@ import (
 func Pddikti(c *messageutil.MessageContext) error {
 	query := c.Parser.GetAllJoinedArg()
 
-	res, err := pddikti.Search(query)
+	res, err := pddikti.Search(c.Context(), query)
 	if err != nil {
 		if errors.Is(err, pddikti.ErrNoKeyOrIv) {
-			c.QuoteReply("This command is not initialized by the owner.\nDebug: Missing PDDIKTI_KEY or PDDIKTI_IV")
+			c.QuoteReplyT("pddikti.no_key")
 		} else {
-			c.QuoteReply("Something went wrong\nDebug: %s", err.Error())
+			c.QuoteReplyT("common.something_wrong", err.Error())
 		}
 		return err
 	}
 
 	if res.IsEmpty() {
-		c.QuoteReply("Not found")
+		c.QuoteReplyT("pddikti.not_found")
 		return nil
 	}
 
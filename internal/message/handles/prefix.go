@@ -0,0 +1,255 @@
+package handles
+
+import (
+	"errors"
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/chatutil/grouputil"
+	"kano/internal/utils/messageutil"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	maxPrefixes   = 5
+	maxPrefixRune = 3
+)
+
+var prefixSubcommands = SubcommandList{
+	{
+		Name:       "set",
+		Func:       prefixSet,
+		Man:        PrefixSetMan,
+		Permission: PermissionGroupAdmin,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "prefix", Type: argutil.TypeString, Required: true, Variadic: true},
+			},
+		},
+	},
+	{
+		Name:       "reset",
+		Func:       prefixReset,
+		Man:        PrefixResetMan,
+		Permission: PermissionGroupAdmin,
+	},
+	{
+		Name:       "mention",
+		Func:       prefixMention,
+		Man:        PrefixMentionMan,
+		Permission: PermissionGroupAdmin,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "state", Type: argutil.TypeString, Required: true},
+			},
+		},
+	},
+}
+
+// Only reached when no subcommand matches
+func PrefixHandler(c *messageutil.MessageContext) error {
+	if len(c.Parser.Args) > 0 {
+		c.QuoteReplyT("prefix.invalid", c.Parser.Args[0].Content.Data, c.Parser.Command.UsedPrefix)
+		return nil
+	}
+
+	prefixes, mention, err := prefixSettings(c)
+	if err != nil {
+		c.QuoteReplyT("prefix.load_failed", err)
+		return err
+	}
+	c.QuoteReply("%s", prefixSummary(c, prefixes, mention))
+	return nil
+}
+
+func prefixSet(c *messageutil.MessageContext) error {
+	var prefixes []string
+	for _, p := range argutil.GetAll[string](c.Args, "prefix") {
+		if err := validatePrefix(c, p); err != nil {
+			c.QuoteReplyT("prefix.bad_prefix", p, err)
+			return nil
+		}
+		if !slices.Contains(prefixes, p) {
+			prefixes = append(prefixes, p)
+		}
+	}
+	if len(prefixes) > maxPrefixes {
+		c.QuoteReplyT("prefix.too_many", maxPrefixes)
+		return nil
+	}
+
+	_, mention, err := prefixSettings(c)
+	if err != nil {
+		c.QuoteReplyT("prefix.load_failed", err)
+		return err
+	}
+	return savePrefixSettings(c, prefixes, mention)
+}
+
+func prefixReset(c *messageutil.MessageContext) error {
+	_, mention, err := prefixSettings(c)
+	if err != nil {
+		c.QuoteReplyT("prefix.load_failed", err)
+		return err
+	}
+	return savePrefixSettings(c, nil, mention)
+}
+
+func prefixMention(c *messageutil.MessageContext) error {
+	state, _ := argutil.Get[string](c.Args, "state")
+
+	var mention bool
+	switch strings.ToLower(state) {
+	case "on", "true", "enable":
+		mention = true
+	case "off", "false", "disable":
+		mention = false
+	default:
+		c.QuoteReplyT("prefix.invalid_state", state)
+		return nil
+	}
+
+	prefixes, _, err := prefixSettings(c)
+	if err != nil {
+		c.QuoteReplyT("prefix.load_failed", err)
+		return err
+	}
+	return savePrefixSettings(c, prefixes, mention)
+}
+
+// Prefixes must not look like regular text, otherwise normal chatting would
+// trigger the bot
+func validatePrefix(c *messageutil.MessageContext, p string) error {
+	if n := utf8.RuneCountInString(p); n == 0 || n > maxPrefixRune {
+		return errors.New(c.T("prefix.bad_length", maxPrefixRune))
+	}
+	for _, r := range p {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) {
+			return errors.New(c.T("prefix.bad_alnum"))
+		}
+		if strings.ContainsRune("'\"`=@", r) {
+			return errors.New(c.T("prefix.bad_symbol"))
+		}
+	}
+	return nil
+}
+
+// Group settings in groups, the sender's own settings in private chats
+func prefixSettings(c *messageutil.MessageContext) ([]string, bool, error) {
+	if isGroupChat(c) {
+		settings, err := groupSettings(c)
+		if err != nil {
+			return nil, false, err
+		}
+		return settings.Prefixes, settings.IsMentionTriggerAllowed, nil
+	}
+	if c.Contact == nil {
+		return nil, false, nil
+	}
+	return c.Contact.Prefixes, c.Contact.IsMentionTriggerAllowed, nil
+}
+
+func savePrefixSettings(c *messageutil.MessageContext, prefixes []string, mention bool) error {
+	var err error
+	if isGroupChat(c) {
+		var settings *grouputil.GroupSettings
+		if settings, err = groupSettings(c); err == nil {
+			settings.Prefixes = prefixes
+			settings.IsMentionTriggerAllowed = mention
+			err = settings.Save()
+		}
+	} else if c.Contact == nil {
+		err = fmt.Errorf("contact info is unavailable")
+	} else {
+		c.Contact.Prefixes = prefixes
+		c.Contact.IsMentionTriggerAllowed = mention
+		err = c.Contact.Save()
+	}
+	if err != nil {
+		c.QuoteReplyT("prefix.save_failed", err)
+		return err
+	}
+
+	c.QuoteReplyT("prefix.saved", prefixSummary(c, prefixes, mention))
+	return nil
+}
+
+func prefixSummary(c *messageutil.MessageContext, prefixes []string, mention bool) string {
+	isDefault := len(prefixes) == 0
+	if isDefault {
+		prefixes = config.GetParser().Prefixes()
+	}
+
+	quoted := make([]string, len(prefixes))
+	for i, p := range prefixes {
+		quoted[i] = fmt.Sprintf("`%s`", p)
+	}
+
+	var msg strings.Builder
+	msg.WriteString(c.T("prefix.current", strings.Join(quoted, " ")))
+	if isDefault {
+		msg.WriteString(c.T("lang.default_mark"))
+	}
+	msg.WriteString("\n" + c.T("prefix.mention", mention))
+	return msg.String()
+}
+
+var PrefixMan = CommandMan{
+	Name: "prefix - configure command prefixes",
+	Synopsis: []string{
+		"*prefix*",
+		"*prefix* *set* _prefix_ ...",
+		"*prefix* *reset*",
+		"*prefix* *mention* *on*|*off*",
+	},
+	Description: []string{
+		"Shows or changes the prefixes that trigger the bot. In group chats the settings apply to the whole group and can only be changed by group admins, in private chats they apply to your own chat.",
+		"Without arguments, shows the current prefixes and whether mentioning the bot triggers it.",
+	},
+	SourceFilename: "prefix.go",
+	SeeAlso: []SeeAlso{
+		{"enable", SeeAlsoTypeCommand},
+	},
+}
+
+var PrefixSetMan = CommandMan{
+	Name: "prefix set - replace the prefixes",
+	Synopsis: []string{
+		"*prefix* *set* _prefix_ ...",
+	},
+	Description: []string{
+		"Replaces the prefixes of this chat. The default prefixes stop working until `prefix reset` is used.",
+		"_prefix_" +
+			"\n{SPACE}1 to 3 characters, without letters, digits, spaces, quotes, `=` or `@`. Up to 5 prefixes can be given." +
+			"\n{SPACE}Example: `prefix set ! #` => `!ping` and `#ping` work, `.ping` doesn't.",
+	},
+	SourceFilename: "prefix.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var PrefixResetMan = CommandMan{
+	Name: "prefix reset - go back to the default prefixes",
+	Synopsis: []string{
+		"*prefix* *reset*",
+	},
+	Description: []string{
+		"Removes the custom prefixes of this chat, so the default ones are used again. The mention trigger is left as is.",
+	},
+	SourceFilename: "prefix.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var PrefixMentionMan = CommandMan{
+	Name: "prefix mention - trigger the bot by mentioning it",
+	Synopsis: []string{
+		"*prefix* *mention* *on*|*off*",
+	},
+	Description: []string{
+		"When on, a message starting with a mention of the bot works like a prefix, e.g. `@kano ping`. Works alongside the other prefixes.",
+	},
+	SourceFilename: "prefix.go",
+	SeeAlso:        []SeeAlso{},
+}
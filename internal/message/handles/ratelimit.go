@@ -0,0 +1,44 @@
+package handles
+
+import (
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/utils/messageutil"
+	"kano/internal/utils/ratelimit"
+	"math"
+)
+
+var limiter = ratelimit.New()
+
+// Checks the command rate limits for the sender and the group. Replies once
+// with the remaining cooldown and stays silent on the following hits.
+func checkRateLimit(c *messageutil.MessageContext, name string, userRule, groupRule ratelimit.Rule) bool {
+	if c.IsSenderSame(config.GetConfig().OwnerJID) {
+		return true
+	}
+
+	var hits []ratelimit.Hit
+	if c.Contact != nil {
+		hits = append(hits, ratelimit.Hit{Key: fmt.Sprintf("contact:%d:%s", c.Contact.ID, name), Rule: userRule})
+	}
+	if c.Group != nil {
+		hits = append(hits, ratelimit.Hit{Key: fmt.Sprintf("group:%d:%s", c.Group.ID, name), Rule: groupRule})
+	}
+
+	// Both limits are checked before either is used, so a command rejected
+	// by the group limit doesn't cost the sender a hit
+	wait, notified := limiter.TakeAll(hits...)
+	if wait == 0 {
+		return true
+	}
+
+	c.Logger.Debugf("Command %s is rate limited for %s", name, wait)
+	if !notified {
+		c.QuoteReplyT("ratelimit.slow_down", int(math.Ceil(wait.Seconds())))
+	}
+	return false
+}
+
+func (h CommandHandler) checkRateLimit(c *messageutil.MessageContext, name string) bool {
+	return checkRateLimit(c, name, h.UserRateLimit, h.GroupRateLimit)
+}
@@ -1,28 +1,29 @@
 package handles
 
 import (
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/httpclient"
 	"kano/internal/utils/messageutil"
 	"net/http"
 	"net/url"
+	"time"
 )
 
-func Redirect(c *messageutil.MessageContext) error {
-	queryUrl := c.Parser.RawArg.Content.Data
+// Only the Location header matters, the body is never read
+var redirectClient = httpclient.New(httpclient.Options{
+	Timeout:    30 * time.Second,
+	MaxRetries: 2,
+	BaseDelay:  time.Second,
+	MaxDelay:   10 * time.Second,
+	NoRedirect: true,
+})
 
-	u, err := url.Parse(queryUrl)
-	if err != nil {
-		c.QuoteReply("Given string is not parsable into a URL")
-		return nil
-	}
+func Redirect(c *messageutil.MessageContext) error {
+	u, _ := argutil.Get[*url.URL](c.Args, "url")
 
-	client := http.Client{
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			return http.ErrUseLastResponse
-		},
-	}
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(c.Context(), "GET", u.String(), nil)
 	if err != nil {
-		c.QuoteReply("Something went wrong\nDebug: %s", err.Error())
+		c.QuoteReplyT("common.something_wrong", err.Error())
 		return err
 	}
 
@@ -30,9 +31,9 @@ func Redirect(c *messageutil.MessageContext) error {
 	// req.Header.Set("User-Agent", "curl/8.17.0")
 	// req.Header.Set("Accept", "*/*")
 
-	resp, err := client.Do(req)
+	resp, err := redirectClient.Do(req)
 	if err != nil {
-		c.QuoteReply("Request error\nDebug: %s", err.Error())
+		c.QuoteReplyT("redirect.failed", err.Error())
 		return err
 	}
 	defer resp.Body.Close()
@@ -11,10 +11,11 @@ import (
 
 // A very inefficient group refresher
 func RefreshGroups(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
 	grps := []models.Group{}
 	tx := db.Find(&grps)
 	if tx.Error != nil {
-		c.QuoteReply("Failed to get group list: %s", tx.Error)
+		c.QuoteReplyT("refresh.groups_failed", tx.Error)
 		return tx.Error
 	}
 
@@ -23,7 +24,7 @@ func RefreshGroups(c *messageutil.MessageContext) error {
 		dbParts := []models.Participant{}
 		tx := db.Preload("Contact").Where("group_id", grp.ID).Find(&dbParts)
 		if tx.Error != nil {
-			c.QuoteReply("Failed to get participant list for group JID %s: %s", jid, tx.Error)
+			c.QuoteReplyT("refresh.participants_failed", jid, tx.Error)
 			return tx.Error
 		}
 
@@ -33,7 +34,7 @@ func RefreshGroups(c *messageutil.MessageContext) error {
 
 		grpInfo, err := c.Client.GetGroupInfo(jid)
 		if err != nil {
-			c.QuoteReply("Failed to get group info for JID %s: %s", jid, tx.Error)
+			c.QuoteReplyT("refresh.info_failed", jid, tx.Error)
 			return err
 		}
 
@@ -46,9 +47,9 @@ func RefreshGroups(c *messageutil.MessageContext) error {
 				return p.Contact.JID.ToNonAD().String() == part.JID.ToNonAD().String()
 			})
 
-			contact, err := contactutil.Init(part.JID, "")
+			contact, err := contactutil.Init(c.Context(), part.JID, "")
 			if err != nil {
-				c.QuoteReply("Failed to get/create contact info for JID %s: %s", part.JID, err)
+				c.QuoteReplyT("refresh.contact_failed", part.JID, err)
 				return err
 			}
 			var role models.ParticipantRole = models.ParticipantRoleMember
@@ -66,19 +67,19 @@ func RefreshGroups(c *messageutil.MessageContext) error {
 			if idx == -1 {
 				tx = db.Create(&model)
 				if tx.Error != nil {
-					c.QuoteReply("Failed to save participant with contact %s group %s: %s", contact.JID, grp.JID, tx.Error)
+					c.QuoteReplyT("refresh.save_failed", contact.JID, grp.JID, tx.Error)
 				}
 			} else {
 				model.ID = dbParts[idx].ID
 				tx = db.Save(&model)
 				if tx.Error != nil {
-					c.QuoteReply("Failed to update participant with contact %s group %s: %s", contact.JID, grp.JID, tx.Error)
+					c.QuoteReplyT("refresh.update_failed", contact.JID, grp.JID, tx.Error)
 				}
 			}
 		}
 	}
 
-	c.QuoteReply("Done")
+	c.QuoteReplyT("common.done")
 
 	return nil
 }
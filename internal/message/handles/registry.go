@@ -0,0 +1,78 @@
+package handles
+
+import (
+	"slices"
+	"strings"
+)
+
+// Read-only view of a command for the status server
+type CommandInfo struct {
+	Name        string        `json:"name"`
+	Aliases     []string      `json:"aliases,omitempty"`
+	Category    string        `json:"category,omitempty"`
+	Permission  string        `json:"permission"`
+	Scope       string        `json:"scope"`
+	Feature     string        `json:"feature,omitempty"`
+	Manual      string        `json:"manual,omitempty"`
+	Subcommands []CommandInfo `json:"subcommands,omitempty"`
+}
+
+func (p CommandPermission) String() string {
+	switch p {
+	case PermissionGroupAdmin:
+		return "group_admin"
+	case PermissionOwner:
+		return "owner"
+	default:
+		return "anyone"
+	}
+}
+
+func (s CommandScope) String() string {
+	switch s {
+	case ScopeGroup:
+		return "group"
+	case ScopePrivate:
+		return "private"
+	default:
+		return "all"
+	}
+}
+
+// Every command sorted by name, with its manual rendered without a prefix
+func Commands() []CommandInfo {
+	res := []CommandInfo{}
+	for name, cmd := range mappedCommands {
+		if commandNames[name] != name {
+			continue // alias
+		}
+
+		info := CommandInfo{
+			Name:       name,
+			Aliases:    cmd.Aliases,
+			Category:   string(cmd.Category),
+			Permission: cmd.Permission.String(),
+			Scope:      cmd.Scope.String(),
+			Feature:    cmd.Feature.Key,
+		}
+		if cmd.Man.Name != "" {
+			info.Manual = renderManual("", name, cmd.Man, cmd.Aliases, cmd.Subcommands)
+		}
+		for _, sub := range cmd.Subcommands {
+			subInfo := CommandInfo{
+				Name:       name + " " + sub.Name,
+				Aliases:    sub.fullAliases(name),
+				Permission: sub.Permission.String(),
+				Scope:      sub.Scope.String(),
+			}
+			if sub.Man.Name != "" {
+				subInfo.Manual = renderManual("", name, sub.Man, subInfo.Aliases, nil)
+			}
+			info.Subcommands = append(info.Subcommands, subInfo)
+		}
+		res = append(res, info)
+	}
+
+	slices.SortFunc(res, func(a, b CommandInfo) int { return strings.Compare(a.Name, b.Name) })
+	return res
+}
@@ -2,7 +2,6 @@ package handles
 
 import (
 	"encoding/json"
-	"kano/internal/config"
 	"kano/internal/utils/messageutil"
 	"kano/internal/utils/six/schedules"
 	"kano/internal/utils/word"
@@ -18,43 +17,39 @@ type IDCode struct {
 }
 
 func ResolveSubject(c *messageutil.MessageContext) error {
-	if !c.IsSenderSame(config.GetConfig().OwnerJID) {
-		return nil
-	}
-
 	args := c.Parser.NamedArgs
 	if len(args) == 0 {
-		c.QuoteReply("Give argument (e.g. .resolve-subject ET1201=12345 ET1202=23455)")
+		c.QuoteReplyT("resolve.usage")
 		return nil
 	}
 
 	fBytes, err := os.ReadFile("dumps/six/subject-id_map.json")
 	if err != nil {
-		c.QuoteReply("Something is wrong: %s", err)
+		c.QuoteReplyT("resolve.failed", err)
 		return err
 	}
 
 	var rep []IDCode
 	err = json.Unmarshal(fBytes, &rep)
 	if err != nil {
-		c.QuoteReply("Something is wrong: %s", err)
+		c.QuoteReplyT("resolve.failed", err)
 		return err
 	}
 
 	for key, val := range args {
 		key = strings.ToUpper(key)
 		if len(val) != 1 {
-			c.QuoteReply("Expected value length is 1, got %d", len(val))
+			c.QuoteReplyT("resolve.bad_value_count", len(val))
 			return nil
 		}
 		id, err := strconv.ParseUint(val[0].Content.Data, 10, 0)
 		if err != nil {
-			c.QuoteReply("Unable to parse %q as uint: %s", val[0].Content.Data, err)
+			c.QuoteReplyT("resolve.bad_id", val[0].Content.Data, err)
 			return nil
 		}
 		code := key
 		if len(code) != 6 {
-			c.QuoteReply("Expected code length is 6, got %d", len(code))
+			c.QuoteReplyT("resolve.bad_code_length", len(code))
 			return nil
 		}
 		validCode := word.IsCharUpper(code[0]) &&
@@ -64,13 +59,13 @@ func ResolveSubject(c *messageutil.MessageContext) error {
 			word.IsCharNumber(code[4]) &&
 			word.IsCharNumber(code[5])
 		if !validCode {
-			c.QuoteReply("Invalid code %s", code)
+			c.QuoteReplyT("resolve.bad_code", code)
 			return nil
 		}
 
 		for _, e := range rep {
 			if e.ID == uint(id) && e.Code != code {
-				c.QuoteReply("Subject id %d is already taken by %s, please check again the id for %s", id, e.Code, code)
+				c.QuoteReplyT("resolve.id_taken", id, e.Code, code)
 				return nil
 			}
 		}
@@ -87,17 +82,17 @@ func ResolveSubject(c *messageutil.MessageContext) error {
 
 	mar, err := json.MarshalIndent(rep, "", "\t")
 	if err != nil {
-		c.QuoteReply("Something is wrong: %s", err)
+		c.QuoteReplyT("resolve.failed", err)
 		return err
 	}
 	err = os.WriteFile("dumps/six/subject-id_map.json", mar, 0644)
 	if err != nil {
-		c.QuoteReply("Something is wrong: %s", err)
+		c.QuoteReplyT("resolve.failed", err)
 		return err
 	}
 
 	schedules.UpdateSubjects()
-	c.QuoteReply("Done")
+	c.QuoteReplyT("common.done")
 
 	return nil
 }
@@ -2,61 +2,76 @@ package handles
 
 import (
 	"kano/internal/message/handles/sawit"
+	"kano/internal/utils/argutil"
 	"kano/internal/utils/messageutil"
 	"strconv"
-)
 
-func SawitHandler(c *messageutil.MessageContext) error {
-	if c.Group == nil {
-		c.QuoteReply("You can only use it in group chats")
-		return nil
-	}
+	"go.mau.fi/whatsmeow/types"
+)
 
-	if !c.Group.GroupSettings.IsGameAllowed {
-		c.Logger.Debugf("Game is not allowed in %s", c.Group.JID)
-		return nil
-	}
+var sawitSubcommands = SubcommandList{
+	{
+		Name:    "grow",
+		Aliases: []string{"g"},
+		Func:    sawit.Grow,
+		Man:     SawitGrowMan,
+	},
+	{
+		Name:    "leaderboard",
+		Aliases: []string{"lb", "l"},
+		Func:    sawit.Leaderboard,
+		Man:     SawitLeaderboardMan,
+	},
+	{
+		Name:    "draobredael",
+		Aliases: []string{"bl"},
+		Func:    sawit.Draobredael,
+		Man:     SawitDraobredaelMan,
+	},
+	{
+		Name:    "stat",
+		Aliases: []string{"sta", "st", "s"},
+		Func:    sawit.Stat,
+		Man:     SawitStatMan,
+	},
+	{
+		Name:    "transfer",
+		Aliases: []string{"tf", "t"},
+		Func:    sawitTransfer,
+		Man:     SawitTransferMan,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "amount", Type: argutil.TypeInt, Required: true},
+				{Name: "target", Type: argutil.TypeMention, Required: true},
+			},
+		},
+	},
+}
 
+// Grows when no arguments are given, otherwise the argument is the attack size
+func SawitHandler(c *messageutil.MessageContext) error {
 	args := c.Parser.Args
 	if len(args) == 0 {
 		return sawit.Grow(c)
 	}
 
 	cmd := args[0].Content.Data
-	switch cmd {
-	case "grow", "g":
-		return sawit.Grow(c)
-	case "leaderboard", "lb", "l":
-		return sawit.Leaderboard(c)
-	case "draobredael", "bl":
-		return sawit.Draobredael(c)
-	case "stat", "sta", "st", "s":
-		return sawit.Stat(c)
-	case "transfer", "tf", "t":
-		if len(args) < 2 {
-			c.QuoteReply("Usage: *sawit transfer* <amount> <target>")
-			return nil
-		}
-		transferAmt, err := strconv.ParseUint(args[1].Content.Data, 10, 0)
-		if err != nil {
-			c.QuoteReply("Invalid transfer amount")
-			return nil
-		}
-		if transferAmt >= (1 << 63) {
-			c.QuoteReply("Invalid transfer amount")
-			return nil
-		}
-		targetJID := args[2].Content.Data[1:]
-		return sawit.Transfer(c, uint(transferAmt), targetJID)
-	default:
-		theNum, err := strconv.ParseUint(cmd, 10, 0)
-		if err != nil {
-			c.QuoteReply("Invalid sawit command %s", cmd)
-			return nil
-		} else {
-			return sawit.Attack(c, uint(theNum))
-		}
+	theNum, err := strconv.ParseUint(cmd, 10, 0)
+	if err != nil {
+		c.QuoteReplyT("sawit.invalid", cmd)
+		return nil
 	}
+	return sawit.Attack(c, uint(theNum))
+}
+
+func sawitTransfer(c *messageutil.MessageContext) error {
+	transferAmt, _ := argutil.Get[int64](c.Args, "amount")
+	if transferAmt <= 0 {
+		c.QuoteReplyT("sawit.transfer.invalid")
+		return nil
+	}
+	target, _ := argutil.Get[types.JID](c.Args, "target")
+	return sawit.Transfer(c, uint(transferAmt), target.User)
 }
 
 var SawitMan = CommandMan{
@@ -71,26 +86,87 @@ var SawitMan = CommandMan{
 	},
 	Description: []string{
 		"Sawit is a simple game where you can grow your sawit and place bets with other players. Player data is scoped per group (different groups have separate data).",
-		"[ *g*|*grow* ]" +
-			"\n{SPACE}Grows your sawit. This action can only be performed once per day and resets at 00:00 UTC. The growth amount is randomly determined within the range of 2 to 20. There is a 10%% probability that the player will be shrunk, which decreases the sawit height instead.",
+		"When no arguments are given, the bot grows your sawit, same as *sawit grow*.",
 		"_attack size_" +
 			"\n{SPACE}Creates a new bet. The attack size must not exceed the current sawit height. A player cannot initiate a bet if their sawit height is less than or equal to 0.",
 		"\n{SPACE}Other players can accept the bet by reacting to the corresponding bot message. The outcome is determined with a 50%% win/loss probability. The winner gains sawit height equal to the attack size, while the loser loses the same amount. This deduction may cause a player's sawit height to become negative, depending on the attack size and their current height.",
-		"*l*|*lb*|*leaderboard*" +
-			"\n{SPACE}Displays the top 10 tallest sawits along with their owners. A [+] indicator is shown if a player has not grown their sawit for the current day.",
-		"*bl*|*draobredael*" +
-			"\n{SPACE}Displays the bottom 10 shortest sawits along with their owners. A [+] indicator is shown if a player has not grown their sawit for the current day." +
-			"\n{SPACE}_Fun fact: “draobredael” is simply “leaderboard” spelled backwards._",
-		"*s*|*st*|*sta*|*stat*" +
-			"\n{SPACE}Displays the current status of the player's sawit, including:" +
+		"_Note: The game is currently due for a redesign due to limited mechanics and lack of creative depth. If you are interested in contributing to a redesign, feel free to reach out._",
+	},
+	SourceFilename: "sawit.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var SawitGrowMan = CommandMan{
+	Name: "sawit grow - grow your sawit",
+	Synopsis: []string{
+		"*sawit* [ *g*|*grow* ]",
+	},
+	Description: []string{
+		"Grows your sawit. This action can only be performed once per day and resets at 00:00 UTC. The growth amount is randomly determined within the range of 2 to 20. There is a 10%% probability that the player will be shrunk, which decreases the sawit height instead.",
+	},
+	SourceFilename: "sawit/grow.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var SawitLeaderboardMan = CommandMan{
+	Name: "sawit leaderboard - show the tallest sawits",
+	Synopsis: []string{
+		"*sawit* *l*|*lb*|*leaderboard*",
+	},
+	Description: []string{
+		"Displays the top 10 tallest sawits along with their owners. A [+] indicator is shown if a player has not grown their sawit for the current day.",
+	},
+	SourceFilename: "sawit/leaderboard.go",
+	SeeAlso: []SeeAlso{
+		{"sawit draobredael", SeeAlsoTypeCommand},
+	},
+}
+
+var SawitDraobredaelMan = CommandMan{
+	Name: "sawit draobredael - show the shortest sawits",
+	Synopsis: []string{
+		"*sawit* *bl*|*draobredael*",
+	},
+	Description: []string{
+		"Displays the bottom 10 shortest sawits along with their owners. A [+] indicator is shown if a player has not grown their sawit for the current day.",
+		"_Fun fact: “draobredael” is simply “leaderboard” spelled backwards._",
+	},
+	SourceFilename: "sawit/leaderboard.go",
+	SeeAlso: []SeeAlso{
+		{"sawit leaderboard", SeeAlsoTypeCommand},
+	},
+}
+
+var SawitStatMan = CommandMan{
+	Name: "sawit stat - show your sawit status",
+	Synopsis: []string{
+		"*sawit* *s*|*st*|*sta*|*stat*",
+	},
+	Description: []string{
+		"Displays the current status of the player's sawit, including:" +
 			"\n{SPACE}- Current sawit height" +
 			"\n{SPACE}- Rank in the top leaderboard" +
 			"\n{SPACE}- Total bets initiated" +
 			"\n{SPACE}- Total wins and losses" +
 			"\n{SPACE}- Bet win rate" +
 			"\n{SPACE}- Total sawit height gained and lost from bets",
-		"_Note: The game is currently due for a redesign due to limited mechanics and lack of creative depth. If you are interested in contributing to a redesign, feel free to reach out._",
 	},
-	SourceFilename: "sawit.go",
+	SourceFilename: "sawit/stat.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var SawitTransferMan = CommandMan{
+	Name: "sawit transfer - give your sawit height to another player",
+	Synopsis: []string{
+		"*sawit* *t*|*tf*|*transfer* _amount_ _target_",
+	},
+	Description: []string{
+		"Transfers some of your sawit height to another player in the same group.",
+		"_amount_" +
+			"\n{SPACE}The height to transfer. Must not exceed your current sawit height.",
+		"_target_" +
+			"\n{SPACE}Mention of the player receiving the height.",
+	},
+	SourceFilename: "sawit/transfer.go",
 	SeeAlso:        []SeeAlso{},
 }
@@ -1,7 +1,6 @@
 package sawit
 
 import (
-	"fmt"
 	"kano/internal/database/models"
 	"kano/internal/utils/messageutil"
 
@@ -9,6 +8,7 @@ import (
 )
 
 func Attack(c *messageutil.MessageContext, attackValue uint) error {
+	db := db.WithContext(c.Context())
 	partId, err := c.GetParticipantID()
 	if err != nil {
 		c.QuoteReply("%s", err)
@@ -17,16 +17,16 @@ func Attack(c *messageutil.MessageContext, attackValue uint) error {
 
 	partSawit, err := GetParticipantSawit(partId)
 	if err != nil {
-		c.QuoteReply("Failed to get participant's sawit: %s", err)
+		c.QuoteReplyT("sawit.get_failed", err)
 		return err
 	}
 
 	if partSawit.Height < int(attackValue) {
-		c.QuoteReply("Your attack size is higher than your sawit height (%d > %d)", attackValue, partSawit.Height)
+		c.QuoteReplyT("sawit.attack.too_big", attackValue, partSawit.Height)
 		return nil
 	}
 
-	resp, err := c.QuoteReply("%s challenged the chat with *%d* cm!\nReact with any emoji to accept the challenge.", partSawit.GetName(), attackValue)
+	resp, err := c.QuoteReplyT("sawit.attack.challenge", partSawit.GetName(), attackValue)
 	if err != nil {
 		return err
 	}
@@ -39,7 +39,7 @@ func Attack(c *messageutil.MessageContext, attackValue uint) error {
 	}
 	tx := db.Create(&toInsert)
 	if tx.Error != nil {
-		msg := fmt.Sprintf("Failed to save sawit attack info: %s", tx.Error)
+		msg := c.T("sawit.attack.save_failed", tx.Error)
 		c.EditMessageWithID(resp.ID, &waE2E.Message{Conversation: &msg})
 
 		return err
@@ -1,6 +1,7 @@
 package sawit
 
 import (
+	"kano/internal/utils/i18n"
 	"kano/internal/utils/messageutil"
 	"math"
 	"math/rand"
@@ -27,21 +28,21 @@ func Grow(c *messageutil.MessageContext) error {
 
 	foundSawit, err := GetParticipantSawit(partId)
 	if err != nil {
-		c.QuoteReply("Failed to get participant's sawit: %s", err)
+		c.QuoteReplyT("sawit.get_failed", err)
 		return err
 	}
 
 	if nowDateStr == foundSawit.LastGrowDate {
-		c.QuoteReply("You already grew your sawit today.\nWait for *%dh %dm*", hour, minute)
+		c.QuoteReplyT("sawit.grow.already", hour, minute)
 		return nil
 	}
 
 	size := r.Intn(19) + 2
-	status := "grown"
+	status := i18n.MessageID("sawit.grow.grown")
 
 	isGrow := r.Float32() < GROW_PROB
 	if !isGrow {
-		status = "shrunk"
+		status = "sawit.grow.shrunk"
 		size = -size
 	}
 
@@ -49,8 +50,8 @@ func Grow(c *messageutil.MessageContext) error {
 
 	if foundSawit.Height < 0 {
 		isForced = true
-		isGrow = true   // Force grow
-		status = "grow" // Following the isGrow
+		isGrow = true               // Force grow
+		status = "sawit.grow.grown" // Following the isGrow
 		if foundSawit.Height < -100 {
 			size = 100
 		} else {
@@ -62,32 +63,32 @@ func Grow(c *messageutil.MessageContext) error {
 	foundSawit.ChangeGrowDate(nowDateStr)
 	err = foundSawit.Save()
 	if err != nil {
-		c.QuoteReply("Failed to save participant's sawit: %s", err)
+		c.QuoteReplyT("sawit.save_failed", err)
 		return err
 	}
 
 	position, err := GetParticipantPosition(c.Group.ID, partId)
 	if err != nil {
-		c.QuoteReply("Failed to get participant sawit's rank position: %s", err)
+		c.QuoteReplyT("sawit.rank_failed", err)
 		return err
 	}
 
 	if isForced {
 		if size == 100 {
-			c.QuoteReply(
-				"Dawg, why are your sawit is so deep, I can only give you 100 cm for now. Your sawit now is *%d cm* height\nYour position in the top is %d.\n\nNext grower in *%dh %dm*",
+			c.QuoteReplyT(
+				"sawit.grow.too_deep",
 				foundSawit.Height, position, hour, minute,
 			)
 		} else {
-			c.QuoteReply(
-				"Your sawit height is negative, huh? I think I will just make it *0 cm* height.\nYour position in the top is %d.\n\nNext grower in *%dh %dm*",
+			c.QuoteReplyT(
+				"sawit.grow.negative",
 				position, hour, minute,
 			)
 		}
 	} else {
-		c.QuoteReply(
-			"Your sawit has %s by *%d cm* and now it is has *%d cm* height.\nYour position in the top is %d.\n\nNext grower in *%dh %dm*",
-			status, int(math.Abs(float64(size))), foundSawit.Height, position, hour, minute,
+		c.QuoteReplyT(
+			status,
+			int(math.Abs(float64(size))), foundSawit.Height, position, hour, minute,
 		)
 	}
 	return nil
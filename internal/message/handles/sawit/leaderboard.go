@@ -9,6 +9,7 @@ import (
 )
 
 func Leaderboard(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
 	founds := []models.Sawit{}
 	tx := db.
 		Preload("Participant.Contact").
@@ -19,12 +20,12 @@ func Leaderboard(c *messageutil.MessageContext) error {
 		Limit(10).
 		Find(&founds)
 	if err := tx.Error; err != nil {
-		c.QuoteReply("Failed to get sawits: %s", err)
+		c.QuoteReplyT("sawit.list_failed", err)
 		return err
 	}
 
 	if len(founds) == 0 {
-		c.QuoteReply("Nobody grows sawit here :(\nNo wowo impressed.")
+		c.QuoteReplyT("sawit.empty")
 		return nil
 	}
 
@@ -32,14 +33,14 @@ func Leaderboard(c *messageutil.MessageContext) error {
 	nowDateStr := now.Format("02-01-2006")
 
 	var msg strings.Builder
-	msg.WriteString("Top of the tallest sawits:\n\n")
+	msg.WriteString(c.T("sawit.leaderboard.top"))
 	for i, f := range founds {
 		name := f.Participant.Contact.CustomName
 		if name == "" {
 			name = f.Participant.Contact.PushName
 		}
 		if name == "" {
-			name = fmt.Sprintf("[Unknown User: %s]", f.Participant.Contact.JID.User)
+			name = c.T("sawit.unknown_user", f.Participant.Contact.JID.User)
 		}
 		stat := ""
 		if nowDateStr != f.LastGrowDate {
@@ -47,13 +48,14 @@ func Leaderboard(c *messageutil.MessageContext) error {
 		}
 		fmt.Fprintf(&msg, "%d | *%s* — *%d* cm%s\n", i+1, name, f.Height, stat)
 	}
-	msg.WriteString("\n_[+] means a grower hasn't grown his sawit today yet._")
+	msg.WriteString(c.T("sawit.leaderboard.legend"))
 
 	c.QuoteReply("%s", msg.String())
 	return nil
 }
 
 func Draobredael(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
 	founds := []models.Sawit{}
 	tx := db.
 		Preload("Participant.Contact").
@@ -64,12 +66,12 @@ func Draobredael(c *messageutil.MessageContext) error {
 		Limit(10).
 		Find(&founds)
 	if err := tx.Error; err != nil {
-		c.QuoteReply("Failed to get sawits: %s", err)
+		c.QuoteReplyT("sawit.list_failed", err)
 		return err
 	}
 
 	if len(founds) == 0 {
-		c.QuoteReply("Nobody grows sawit here :(\nNo wowo impressed.")
+		c.QuoteReplyT("sawit.empty")
 		return nil
 	}
 
@@ -77,14 +79,14 @@ func Draobredael(c *messageutil.MessageContext) error {
 	nowDateStr := now.Format("02-01-2006")
 
 	var msg strings.Builder
-	msg.WriteString("Top of the shortest sawits:\n\n")
+	msg.WriteString(c.T("sawit.leaderboard.bottom"))
 	for i, f := range founds {
 		name := f.Participant.Contact.CustomName
 		if name == "" {
 			name = f.Participant.Contact.PushName
 		}
 		if name == "" {
-			name = fmt.Sprintf("[Unknown User: %s]", f.Participant.Contact.JID.User)
+			name = c.T("sawit.unknown_user", f.Participant.Contact.JID.User)
 		}
 		stat := ""
 		if nowDateStr != f.LastGrowDate {
@@ -92,7 +94,7 @@ func Draobredael(c *messageutil.MessageContext) error {
 		}
 		fmt.Fprintf(&msg, "%d | *%s* — *%d* cm%s\n", i+1, name, f.Height, stat)
 	}
-	msg.WriteString("\n_[+] means a grower hasn't grown his sawit today yet._")
+	msg.WriteString(c.T("sawit.leaderboard.legend"))
 
 	c.QuoteReply("%s", msg.String())
 	return nil
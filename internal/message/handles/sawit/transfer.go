@@ -16,25 +16,25 @@ func Transfer(c *messageutil.MessageContext, transferAmt uint, targetJID string)
 
 	participantSawit, err := GetParticipantSawit(participantId)
 	if err != nil {
-		c.QuoteReply("Failed to get participant's sawit: %s", err)
+		c.QuoteReplyT("sawit.get_failed", err)
 		return err
 	}
 
 	if participantSawit.Height < int(transferAmt) {
-		c.QuoteReply("Your transfer size is higher than your sawit height (%d > %d)", transferAmt, participantSawit.Height)
+		c.QuoteReplyT("sawit.transfer.too_big", transferAmt, participantSawit.Height)
 		return nil
 	}
 
 	targetPart, err := c.Group.GetParticipantByJID(types.NewJID(targetJID, types.HiddenUserServer))
 
 	if err != nil {
-		c.QuoteReply("Failed to get target participant: %s", err)
+		c.QuoteReplyT("sawit.transfer.target_failed", err)
 		return err
 	}
 
 	targetPartSawit, err := GetParticipantSawit(targetPart.ID)
 	if err != nil {
-		c.QuoteReply("Failed to get target participant's sawit: %s", err)
+		c.QuoteReplyT("sawit.transfer.target_sawit", err)
 		return err
 	}
 
@@ -44,7 +44,7 @@ func Transfer(c *messageutil.MessageContext, transferAmt uint, targetJID string)
 	participantSawit.AddHeight(-int(transferAmt))
 	participantSawit.Save()
 
-	c.QuoteReply("Successfully transferred *%d* cm from %s to %s!", transferAmt, participantSawit.GetName(), targetPartSawit.GetName())
+	c.QuoteReplyT("sawit.transfer.success", transferAmt, participantSawit.GetName(), targetPartSawit.GetName())
 
 	return nil
 }
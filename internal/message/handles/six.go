@@ -2,37 +2,318 @@ package handles
 
 import (
 	"kano/internal/message/handles/six"
+	"kano/internal/utils/argutil"
 	"kano/internal/utils/messageutil"
-	"strings"
 )
 
+var sixSubcommands = SubcommandList{
+	{
+		Name:    "follow",
+		Aliases: []string{"f"},
+		Func:    six.FollowHandler,
+		Man:     SixFollowMan,
+		Scope:   ScopePrivate,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				// Codes are parsed by the handler, so one bad code doesn't fail the rest
+				{Name: "subject_code", Type: argutil.TypeString, Required: true, Variadic: true},
+			},
+		},
+	},
+	{
+		Name:    "unfollow",
+		Aliases: []string{"uf"},
+		Func:    six.UnfollowHandler,
+		Man:     SixUnfollowMan,
+		Scope:   ScopePrivate,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "subject_code", Type: argutil.TypeString, Required: true, Variadic: true},
+			},
+		},
+	},
+	{
+		Name:    "reminder",
+		Aliases: []string{"r"},
+		Func:    six.ReminderHandler,
+		Man:     SixReminderMan,
+		Scope:   ScopePrivate,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				// Either a class code or one of remove, pause, mute and resume
+				{Name: "action", Type: argutil.TypeString},
+				{Name: "args", Type: argutil.TypeString, Variadic: true},
+			},
+		},
+	},
+	{
+		Name:    "digest",
+		Aliases: []string{"d"},
+		Func:    six.DigestHandler,
+		Man:     SixDigestMan,
+		Scope:   ScopePrivate,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "mode", Type: argutil.TypeString},
+			},
+		},
+	},
+	{
+		Name:    "jadwal",
+		Aliases: []string{"j"},
+		Func:    six.JadwalHandler,
+		Man:     SixJadwalMan,
+		Scope:   ScopePrivate,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "when", Type: argutil.TypeString},
+			},
+		},
+	},
+	{
+		Name:    "cek",
+		Aliases: []string{"c", "check"},
+		Func:    six.CekHandler,
+		Man:     SixCekMan,
+		Args: argutil.Schema{
+			Positional: []argutil.Spec{
+				{Name: "class_code", Type: argutil.TypeString, Required: true, Variadic: true},
+			},
+		},
+	},
+	{
+		Name: "help",
+		Func: sixHelp,
+		Man:  SixHelpMan,
+	},
+	{
+		Name:       "update",
+		Aliases:    []string{"u"},
+		Func:       six.UpdateHandler,
+		Man:        SixUpdateMan,
+		Permission: PermissionOwner,
+	},
+}
+
+// Only reached when no subcommand matches
 func Six(c *messageutil.MessageContext) error {
 	args := c.Parser.Args
 	if len(args) == 0 {
-		return six.CommandMap["help"](c)
+		return sixHelp(c)
 	}
 
-	sixCommand := strings.ToLower(args[0].Content.Data)
-	theFunc, ok := six.CommandMap[sixCommand]
-	if !ok {
-		c.QuoteReply("Perintah SIX tidak valid: %s", sixCommand)
-		return nil
-	} else {
-		return theFunc(c)
+	c.QuoteReplyT("six.invalid", args[0].Content.Data, c.Parser.Command.UsedPrefix)
+	return nil
+}
+
+func sixHelp(c *messageutil.MessageContext) error {
+	cmd := mappedCommands["six"]
+	pref := c.Parser.Command.UsedPrefix
+
+	args := c.Parser.Args
+	if len(args) > 1 {
+		if sub, ok := cmd.Subcommands.Find(args[1].Content.Data); ok {
+			c.QuoteReply("%s", renderManual(pref, "six", sub.Man, sub.fullAliases("six"), nil))
+			return nil
+		}
 	}
+
+	c.QuoteReply("%s", renderManual(pref, "six", cmd.Man, cmd.Aliases, cmd.Subcommands))
+	return nil
 }
 
 var SixMan = CommandMan{
 	Name: "six - SIX utilities",
 	Synopsis: []string{
+		"*six* *f*|*follow* _subject_code_ ...",
+		"*six* *f*|*follow* *list*",
+		"*six* *uf*|*unfollow* _subject_code_ ...|*all*",
+		"*six* *r*|*reminder* [ _subject_code_ [ [ *^* ][ *+*|*-* ] _offset_ ] ]",
+		"*six* *r*|*reminder* *remove* _subject_code_ [ _offset_ ]|*all*",
+		"*six* *r*|*reminder* *pause* _until_|*mute*|*resume*",
+		"*six* *d*|*digest* [ *daily*|*weekly*|*both*|*off* ]",
+		"*six* *j*|*jadwal* [ *today*|*week*|_date_ ]",
+		"*six* *c*|*cek* _subject_code_ _subject_code_ ...",
+		"*six* *help* [ _subcommand_ ]",
 		"*six* *u*|*update* [ _cookie_ ]",
-		"*six* *help*",
-		"*six* *f*|*follow* _subject_code_",
+	},
+	Description: []string{
+		"Utilities related to the SIX ITB academic platform. For now, only utilities related to class schedules and class info are available.",
+	},
+	SourceFilename: "six.go",
+	SeeAlso:        []SeeAlso{},
+}
+
+var SixFollowMan = CommandMan{
+	Name: "six follow - follow class changes",
+	Synopsis: []string{
+		"*six* *f*|*follow* _subject_code_ ...",
+		"*six* *f*|*follow* *list*",
+	},
+	Description: []string{
+		"Follow every change of a class, such as changes in the class schedule, room, activity and/or method, and, if any, changes in the quota and lecturers too. " +
+			"Updates are checked hourly, so the info may be delayed by up to 1 hour. Can only be used in private chat.",
+		"_subject_code_" +
+			"\n{SPACE}Subject code and class number separated by a dash. The class number can be written as `1` or `01`. Several codes can be given at once, separated by spaces, and the bot reports the result of each." +
+			"\n{SPACE}Example: `ET2202-01`, `ET2201-2`, `ET1201-01 MA1101-03 FI1101-02`.",
+		"*list*" +
+			"\n{SPACE}Lists the classes you follow.",
+	},
+	SourceFilename: "six/follow.go",
+	SeeAlso: []SeeAlso{
+		{"six unfollow", SeeAlsoTypeCommand},
+		{"six reminder", SeeAlsoTypeCommand},
+	},
+}
+
+var SixUnfollowMan = CommandMan{
+	Name: "six unfollow - stop following class changes",
+	Synopsis: []string{
+		"*six* *uf*|*unfollow* _subject_code_ ...",
+		"*six* *uf*|*unfollow* *all*",
+	},
+	Description: []string{
+		"Stop receiving changes of the given classes. Reminders set with `six reminder` are not affected. Can only be used in private chat.",
+		"_subject_code_" +
+			"\n{SPACE}Subject code and class number separated by a dash, same as in `six follow`. Several codes can be given at once." +
+			"\n{SPACE}Example: `ET2202-01`, `ET1201-01 MA1101-03`.",
+		"*all*" +
+			"\n{SPACE}Stop following every class at once.",
+	},
+	SourceFilename: "six/follow.go",
+	SeeAlso: []SeeAlso{
+		{"six follow", SeeAlsoTypeCommand},
+	},
+}
+
+var SixReminderMan = CommandMan{
+	Name: "six reminder - remind the class schedule",
+	Synopsis: []string{
+		"*six* *r*|*reminder*",
 		"*six* *r*|*reminder* _subject_code_ [ [ *^* ][ *+*|*-* ] _offset_ ]",
+		"*six* *r*|*reminder* *remove* _subject_code_ [ _offset_ ]",
+		"*six* *r*|*reminder* *remove* *all*",
+		"*six* *r*|*reminder* *pause* _until_",
+		"*six* *r*|*reminder* *mute*|*resume*",
 	},
 	Description: []string{
-		"Utilities related to the SIX ITB academic platform. Use `.six help` for more detailed information.",
+		"Add a new reminder for a class schedule. The reminder can be set right when the class starts or ends, and can be shifted as needed. " +
+			"When no arguments are given, the bot will list the reminders you have set. Can only be used in private chat.",
+		"_subject_code_" +
+			"\n{SPACE}Subject code and class number separated by a dash. The class number can be written as `1` or `01`." +
+			"\n{SPACE}Example: `ET2202-01`, `ET2201-2`.",
+		"_offset_" +
+			"\n{SPACE}Shifts the reminder time, in minutes. When not given, the reminder is sent right when the class starts." +
+			"\n{SPACE}The unit can be changed with the letter *m* (minutes), *h* (hours) or *d* (days), and can be combined as long as they are ordered (d > h > m). The minimum is 1 minute and the maximum is 1 week (7d = 168h = 10080m)." +
+			"\n{SPACE}Example: `10` => 10 minutes, `3h` => 3 hours, `2d12h20m` => 2 days 12 hours 20 minutes.",
+		"*+*|*-*" +
+			"\n{SPACE}Optional. Without a sign or with *+*, the reminder is shifted after the class starts. With *-*, the reminder is shifted before the class starts." +
+			"\n{SPACE}Example: `-20m` => 20 minutes before the class starts, `+1h16m` => 1 hour 16 minutes after the class starts.",
+		"*^*" +
+			"\n{SPACE}Optional. Use the time the class ends as the reference instead of the time it starts." +
+			"\n{SPACE}Example: `^-10m` => 10 minutes before the class ends, `^` => right when the class ends.",
+		"*remove*" +
+			"\n{SPACE}Removes the reminder of the class with the given offset, or every reminder of the class when no offset is given. *all* removes every reminder you have set.",
+		"*pause* _until_" +
+			"\n{SPACE}Skips every reminder until the given date (inclusive), e.g. during holidays. The date is written as `2026-03-01` or `01-03-2026`. A duration such as `7d` or `12h` pauses from now instead.",
+		"*mute*|*resume*" +
+			"\n{SPACE}*mute* skips every reminder until *resume* is used. *resume* also ends a pause. Reminders skipped while muted or paused are not sent later.",
+		"*Snooze*" +
+			"\n{SPACE}React to a reminder message to send it again later. Reacting with 1️⃣ to 9️⃣ snoozes it for that many times 10 minutes, any other emoji snoozes it for 10 minutes. Removing the reaction cancels the snooze.",
+	},
+	SourceFilename: "six/reminder.go",
+	SeeAlso: []SeeAlso{
+		{"six follow", SeeAlsoTypeCommand},
+	},
+}
+
+var SixDigestMan = CommandMan{
+	Name: "six digest - daily and weekly timetable",
+	Synopsis: []string{
+		"*six* *d*|*digest* [ *daily*|*weekly*|*both*|*off* ]",
+	},
+	Description: []string{
+		"Receive the schedule of the classes you follow with `six follow`: every morning the classes of that day, and every Sunday evening an overview of the coming week. " +
+			"Each entry shows the time, class, activity, method and rooms. Days without classes are skipped. When no option is given, the bot shows your current choice. Can only be used in private chat.",
+		"*daily*|*weekly*|*both*" +
+			"\n{SPACE}Receive the daily digest, the weekly digest, or both of them.",
+		"*off*" +
+			"\n{SPACE}Stop receiving the digests.",
+	},
+	SourceFilename: "six/digest.go",
+	SeeAlso: []SeeAlso{
+		{"six follow", SeeAlsoTypeCommand},
+		{"six reminder", SeeAlsoTypeCommand},
+	},
+}
+
+var SixJadwalMan = CommandMan{
+	Name: "six jadwal - show your timetable",
+	Synopsis: []string{
+		"*six* *j*|*jadwal* [ *today*|*week*|_date_ ]",
+	},
+	Description: []string{
+		"Show the schedule of the classes you follow with `six follow`, with the time, activity, method and rooms of each entry. " +
+			"Without any option, shows today's schedule. Can only be used in private chat.",
+		"*today*" +
+			"\n{SPACE}Today's schedule, same as giving no option.",
+		"*week*" +
+			"\n{SPACE}The schedule of the next 7 days starting today, grouped by day.",
+		"_date_" +
+			"\n{SPACE}The schedule of the given day, written as YYYY-MM-DD, DD-MM-YYYY or DD/MM/YYYY." +
+			"\n{SPACE}Example: `2025-02-17`, `17/02/2025`.",
+	},
+	SourceFilename: "six/timetable.go",
+	SeeAlso: []SeeAlso{
+		{"six follow", SeeAlsoTypeCommand},
+		{"six digest", SeeAlsoTypeCommand},
+		{"six cek", SeeAlsoTypeCommand},
+	},
+}
+
+var SixCekMan = CommandMan{
+	Name: "six cek - check classes for schedule conflicts",
+	Synopsis: []string{
+		"*six* *c*|*cek* _subject_code_ _subject_code_ ...",
+	},
+	Description: []string{
+		"Check whether the given classes have overlapping schedules, e.g. before registering for them. Every schedule of the classes is compared, including exams. " +
+			"Each conflicting pair of classes is reported once, with how many times they overlap and when the first overlap is. You don't need to follow the classes.",
+		"_subject_code_" +
+			"\n{SPACE}Subject code and class number separated by a dash, same as in `six follow`. At least two different classes are needed." +
+			"\n{SPACE}Example: `ET2202-01 MA1101-03 FI1101-02`.",
+	},
+	SourceFilename: "six/timetable.go",
+	SeeAlso: []SeeAlso{
+		{"six jadwal", SeeAlsoTypeCommand},
+		{"six follow", SeeAlsoTypeCommand},
+	},
+}
+
+var SixHelpMan = CommandMan{
+	Name: "six help - show the SIX utilities manual",
+	Synopsis: []string{
+		"*six* *help* [ _subcommand_ ]",
+	},
+	Description: []string{
+		"Same as `help six`, or `help six` _subcommand_ when _subcommand_ is given.",
 	},
 	SourceFilename: "six.go",
+	SeeAlso: []SeeAlso{
+		{"help", SeeAlsoTypeCommand},
+	},
+}
+
+var SixUpdateMan = CommandMan{
+	Name: "six update - force update the SIX schedules",
+	Synopsis: []string{
+		"*six* *u*|*update* [ _cookie_ ]",
+	},
+	Description: []string{
+		"SIX schedules are usually updated hourly, right at minute 00. In certain circumstances, this command can be used to force the update. Can only be executed by the owner of the bot.",
+		"_cookie_" +
+			"\n{SPACE}Optional. Replaces the stored SIX session cookie before updating.",
+	},
+	SourceFilename: "six/update.go",
 	SeeAlso:        []SeeAlso{},
 }
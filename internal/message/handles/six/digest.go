@@ -0,0 +1,75 @@
+package six
+
+import (
+	"fmt"
+	"kano/internal/database/models"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/i18n"
+	"kano/internal/utils/messageutil"
+	"strings"
+
+	"go.mau.fi/whatsmeow/types"
+	"gorm.io/gorm/clause"
+)
+
+func DigestHandler(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
+	jid := c.GetChat()
+	if jid.Server == types.DefaultUserServer {
+		c.QuoteReplyT("six.sender_failed", jid)
+		return fmt.Errorf("unable to resolve sender jid: %s", jid)
+	}
+
+	digest := models.ScheduleDigest{Jid: jid}
+	mode, ok := argutil.Get[string](c.Args, "mode")
+	if !ok {
+		tx := db.Where("jid = ?", jid).Limit(1).Find(&digest)
+		if tx.Error != nil {
+			c.QuoteReplyT("six.internal_error", tx.Error)
+			return tx.Error
+		}
+
+		c.QuoteReplyT("six.digest.status", c.T(digestState(digest)), c.Parser.Command.UsedPrefix)
+		return nil
+	}
+
+	switch strings.ToLower(mode) {
+	case "daily":
+		digest.Daily = true
+	case "weekly":
+		digest.Weekly = true
+	case "both":
+		digest.Daily, digest.Weekly = true, true
+	case "off":
+	default:
+		c.QuoteReplyT("six.digest.invalid", mode)
+		return nil
+	}
+
+	tx := db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "jid"}},
+			DoUpdates: clause.AssignmentColumns([]string{"daily", "weekly"}),
+		}).
+		Create(&digest)
+	if tx.Error != nil {
+		c.QuoteReplyT("six.internal_error", tx.Error)
+		return tx.Error
+	}
+
+	c.QuoteReplyT("six.digest.saved", c.T(digestState(digest)))
+	return nil
+}
+
+func digestState(d models.ScheduleDigest) i18n.MessageID {
+	switch {
+	case d.Daily && d.Weekly:
+		return "six.digest.both"
+	case d.Daily:
+		return "six.digest.daily"
+	case d.Weekly:
+		return "six.digest.weekly"
+	default:
+		return "six.digest.off"
+	}
+}
@@ -1,66 +1,116 @@
 package six
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"kano/internal/database/models"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/i18n"
 	"kano/internal/utils/messageutil"
+	"slices"
+	"strings"
 
 	"go.mau.fi/whatsmeow/types"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-func followHandler(c *messageutil.MessageContext) error {
+func FollowHandler(c *messageutil.MessageContext) error {
 	jid := c.GetChat()
 	if jid.Server == types.DefaultUserServer {
-		c.QuoteReply("Gagal mengambil ID pengguna %q", jid)
+		c.QuoteReplyT("six.sender_failed", jid)
 		return fmt.Errorf("unable to resolve sender jid: %s", jid)
 	}
-	if jid.Server != types.HiddenUserServer {
-		c.QuoteReply("Lakukan di private chat.")
-		return nil
+
+	codes := argutil.GetAll[string](c.Args, "subject_code")
+	if len(codes) == 1 && strings.EqualFold(codes[0], "list") {
+		return followList(c)
 	}
 
-	args := c.Parser.Args
-	if len(args) == 1 {
-		followHelp(c)
-		return nil
+	return eachClass(c, codes, "six.follow.report", followOne)
+}
+
+func UnfollowHandler(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
+	jid := c.GetChat()
+	if jid.Server == types.DefaultUserServer {
+		c.QuoteReplyT("six.sender_failed", jid)
+		return fmt.Errorf("unable to resolve sender jid: %s", jid)
 	}
 
-	classCtx := args[1].Content.Data
-	classCode, classNum, err := parseClassCtx(classCtx)
-	if err != nil {
-		c.QuoteReply("Format kelas salah: %s. Contoh yang benar: `ET1201-01`", err)
+	codes := argutil.GetAll[string](c.Args, "subject_code")
+	if len(codes) == 1 && strings.EqualFold(codes[0], "all") {
+		tx := db.Where("jid = ?", jid).Delete(&models.ClassFollower{})
+		if tx.Error != nil {
+			c.QuoteReplyT("six.internal_error", tx.Error)
+			return tx.Error
+		}
+
+		if tx.RowsAffected == 0 {
+			c.QuoteReplyT("six.follow.empty", c.Parser.Command.UsedPrefix)
+		} else {
+			c.QuoteReplyT("six.unfollow.all", tx.RowsAffected)
+		}
 		return nil
 	}
 
-	foundSubjectClass := models.SubjectClass{
-		Subject: models.Subject{Code: classCode},
-		Number:  classNum,
-	}
+	return eachClass(c, codes, "six.unfollow.report", unfollowOne)
+}
 
-	stmt := db.
-		Model(&models.SubjectClass{}).
-		InnerJoins("Subject").
-		Where("number = ? AND code = ?", classNum, classCode)
+// Runs fn for every given class code. A single code gets fn's reply as is,
+// several codes get one reply listing the result of each.
+func eachClass(
+	c *messageutil.MessageContext,
+	codes []string,
+	header i18n.MessageID,
+	fn func(c *messageutil.MessageContext, db *gorm.DB, class models.SubjectClass) (string, error),
+) error {
+	db := db.WithContext(c.Context())
 
-	tx := stmt.First(&foundSubjectClass)
-	if err = tx.Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.QuoteReply("Tidak dapat menemukan matkul %s kelas %02d. Jika ini merupakan kesalahan, coba hubungi pemilik bot.", classCode, classNum)
-			return nil
-		} else {
-			c.QuoteReply("Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s", err)
-			return err
+	parsed := argutil.ParseClassCodes(codes)
+	lines := make([]string, 0, len(parsed))
+	var lastErr error
+	for _, code := range parsed {
+		if code.Err != nil {
+			lines = append(lines, c.T("six.bad_code", code.Raw, code.Err))
+			continue
 		}
+		class := code.Class
+
+		found, err := findClass(db, class)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				lines = append(lines, c.T("six.class_not_found", class.Code, class.Number))
+			} else {
+				lines = append(lines, c.T("six.internal_error", err))
+				lastErr = err
+			}
+			continue
+		}
+
+		line, err := fn(c, db, found)
+		if err != nil {
+			lastErr = err
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) == 1 {
+		c.QuoteReply("%s", lines[0])
+	} else {
+		c.QuoteReply("%s\n\n- %s", c.T(header), strings.Join(lines, "\n- "))
 	}
+	return lastErr
+}
 
+func followOne(c *messageutil.MessageContext, db *gorm.DB, class models.SubjectClass) (string, error) {
 	toInsert := models.ClassFollower{
-		Jid:            jid,
-		SubjectClassID: foundSubjectClass.ID,
+		Jid:            c.GetChat(),
+		SubjectClassID: class.ID,
 	}
 
-	tx = db.
+	tx := db.
 		Where(
 			"jid = ? AND subject_class_id = ?",
 			toInsert.Jid, toInsert.SubjectClassID,
@@ -68,15 +118,57 @@ func followHandler(c *messageutil.MessageContext) error {
 		Attrs(toInsert).
 		FirstOrCreate(&toInsert)
 	if tx.Error != nil {
-		c.QuoteReply("Gagal menambahkan status mengikuti kelas: Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s", tx.Error)
-		return tx.Error
+		return c.T("six.follow.failed", tx.Error), tx.Error
 	}
 
 	if tx.RowsAffected == 0 {
-		c.QuoteReply("Sudah pernah mengikuti %s-%02d (%s).", classCode, classNum, foundSubjectClass.Subject.Name)
-	} else {
-		c.QuoteReply("Berhasil mengikuti %s-%02d (%s).", classCode, classNum, foundSubjectClass.Subject.Name)
+		return c.T("six.follow.already", class.Subject.Code, class.Number, class.Subject.Name), nil
+	}
+	return c.T("six.follow.success", class.Subject.Code, class.Number, class.Subject.Name), nil
+}
+
+func unfollowOne(c *messageutil.MessageContext, db *gorm.DB, class models.SubjectClass) (string, error) {
+	tx := db.
+		Where("jid = ? AND subject_class_id = ?", c.GetChat(), class.ID).
+		Delete(&models.ClassFollower{})
+	if tx.Error != nil {
+		return c.T("six.internal_error", tx.Error), tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return c.T("six.unfollow.not_following", class.Subject.Code, class.Number, class.Subject.Name), nil
+	}
+	return c.T("six.unfollow.success", class.Subject.Code, class.Number, class.Subject.Name), nil
+}
+
+func followList(c *messageutil.MessageContext) error {
+	found, err := gorm.G[models.ClassFollower](db.WithContext(c.Context())).
+		Joins(clause.InnerJoin.Association("SubjectClass.Subject"), models.NoopJoin).
+		Where("jid = ?", c.GetChat()).
+		Find(c.Context())
+	if err != nil {
+		c.QuoteReplyT("six.internal_error", err)
+		return err
+	}
+
+	if len(found) == 0 {
+		c.QuoteReplyT("six.follow.empty", c.Parser.Command.UsedPrefix)
+		return nil
+	}
+
+	slices.SortFunc(found, func(a, b models.ClassFollower) int {
+		return cmp.Or(
+			strings.Compare(a.SubjectClass.Subject.Code, b.SubjectClass.Subject.Code),
+			cmp.Compare(a.SubjectClass.Number, b.SubjectClass.Number),
+		)
+	})
+
+	var msg strings.Builder
+	msg.WriteString(c.T("six.follow.list_header", len(found)))
+	for _, f := range found {
+		fmt.Fprintf(&msg, "\n- %s-%02d (%s)", f.SubjectClass.Subject.Code, f.SubjectClass.Number, f.SubjectClass.Subject.Name)
 	}
 
+	c.QuoteReply("%s", msg.String())
 	return nil
 }
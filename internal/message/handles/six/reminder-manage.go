@@ -0,0 +1,181 @@
+package six
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/database/models"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/datetime"
+	"kano/internal/utils/messageutil"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func reminderRemove(c *messageutil.MessageContext, args []string) error {
+	db := db.WithContext(c.Context())
+	jid := c.GetChat()
+	pref := c.Parser.Command.UsedPrefix
+	if len(args) == 0 {
+		c.QuoteReplyT("six.reminder.remove_usage", pref, pref)
+		return nil
+	}
+
+	if strings.EqualFold(args[0], "all") {
+		tx := db.Where("jid = ?", jid).Delete(&models.ClassReminder{})
+		if tx.Error != nil {
+			c.QuoteReplyT("six.internal_error", tx.Error)
+			return tx.Error
+		}
+
+		if tx.RowsAffected == 0 {
+			c.QuoteReplyT("six.reminder.remove_none")
+		} else {
+			c.QuoteReplyT("six.reminder.removed_all", tx.RowsAffected)
+		}
+		return nil
+	}
+
+	class, err := argutil.ParseClassCode(args[0])
+	if err != nil {
+		c.QuoteReplyT("six.bad_code", args[0], err)
+		return nil
+	}
+
+	found, err := findClass(db, class)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.QuoteReplyT("six.class_not_found", class.Code, class.Number)
+			return nil
+		}
+		c.QuoteReplyT("six.internal_error", err)
+		return err
+	}
+
+	stmt := db.Where("jid = ? AND subject_class_id = ?", jid, found.ID)
+	// Without an offset every reminder of the class goes
+	if len(args) > 1 {
+		offset, anchorAtEnd, err := parseOffset(args[1])
+		if err != nil {
+			c.QuoteReplyT("six.reminder.bad_offset", err)
+			return nil
+		}
+		stmt = stmt.Where("anchor_at_end = ? AND offset_minutes = ?", anchorAtEnd, offset)
+	}
+
+	tx := stmt.Delete(&models.ClassReminder{})
+	if tx.Error != nil {
+		c.QuoteReplyT("six.internal_error", tx.Error)
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		c.QuoteReplyT("six.reminder.remove_none")
+	} else {
+		c.QuoteReplyT("six.reminder.removed", tx.RowsAffected, found.Subject.Code, found.Number, found.Subject.Name)
+	}
+	return nil
+}
+
+func reminderPause(c *messageutil.MessageContext, args []string) error {
+	pref := c.Parser.Command.UsedPrefix
+	if len(args) == 0 {
+		c.QuoteReplyT("six.reminder.pause_usage", pref, pref)
+		return nil
+	}
+
+	until, label, err := parsePauseUntil(args[0], time.Now(), config.GetConfig().Timezone)
+	if err != nil {
+		c.QuoteReplyT("six.reminder.bad_date", args[0])
+		return nil
+	}
+	if !until.After(time.Now()) {
+		c.QuoteReplyT("six.reminder.date_past", label)
+		return nil
+	}
+
+	err = saveReminderSetting(c, models.ReminderSetting{
+		PausedUntil: sql.NullTime{Time: until, Valid: true},
+	}, "paused_until")
+	if err != nil {
+		return err
+	}
+
+	c.QuoteReplyT("six.reminder.paused", label)
+	return nil
+}
+
+func reminderMute(c *messageutil.MessageContext) error {
+	if err := saveReminderSetting(c, models.ReminderSetting{Muted: true}, "muted"); err != nil {
+		return err
+	}
+
+	c.QuoteReplyT("six.reminder.muted", c.Parser.Command.UsedPrefix)
+	return nil
+}
+
+// Pausing until now instead of clearing it keeps the reminders missed earlier
+// today from being sent all at once
+func reminderResume(c *messageutil.MessageContext) error {
+	err := saveReminderSetting(c, models.ReminderSetting{
+		Muted:       false,
+		PausedUntil: sql.NullTime{Time: time.Now(), Valid: true},
+	}, "muted", "paused_until")
+	if err != nil {
+		return err
+	}
+
+	c.QuoteReplyT("six.reminder.resumed")
+	return nil
+}
+
+func saveReminderSetting(c *messageutil.MessageContext, setting models.ReminderSetting, columns ...string) error {
+	setting.Jid = c.GetChat()
+	tx := db.WithContext(c.Context()).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "jid"}},
+			DoUpdates: clause.AssignmentColumns(columns),
+		}).
+		Create(&setting)
+	if tx.Error != nil {
+		c.QuoteReplyT("six.internal_error", tx.Error)
+	}
+	return tx.Error
+}
+
+// Shown on top of the reminder list, empty when reminders are active
+func reminderStatus(c *messageutil.MessageContext) string {
+	var setting models.ReminderSetting
+	tx := db.WithContext(c.Context()).Where("jid = ?", c.GetChat()).Limit(1).Find(&setting)
+	if tx.Error != nil || tx.RowsAffected == 0 {
+		return ""
+	}
+
+	if setting.Muted {
+		return c.T("six.reminder.status_muted", c.Parser.Command.UsedPrefix)
+	}
+	if setting.PausedUntil.Valid && setting.PausedUntil.Time.After(time.Now()) {
+		until := setting.PausedUntil.Time.In(config.GetConfig().Timezone)
+		return c.T("six.reminder.status_paused", until.Format("2006-01-02 15:04"))
+	}
+	return ""
+}
+
+// A date pauses through the end of that day, a duration (7d, 12h) pauses
+// from now
+func parsePauseUntil(s string, now time.Time, loc *time.Location) (time.Time, string, error) {
+	if date, ok := datetime.ParseDate(s, loc); ok {
+		return date.AddDate(0, 0, 1), date.Format("2006-01-02"), nil
+	}
+
+	dur, err := argutil.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("not a date or duration: %s", s)
+	}
+	until := now.Add(dur).In(loc)
+	return until, until.Format("2006-01-02 15:04"), nil
+}
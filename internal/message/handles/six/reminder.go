@@ -1,10 +1,11 @@
 package six
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"kano/internal/database/models"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/i18n"
 	"kano/internal/utils/messageutil"
 	"math"
 	"strings"
@@ -17,43 +18,48 @@ import (
 
 const OFFSET_MAX = 10080
 
-func reminderHandler(c *messageutil.MessageContext) error {
+func ReminderHandler(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
 	jid := c.GetChat()
 	if jid.Server == types.DefaultUserServer {
-		c.QuoteReply("Gagal mengambil ID pengguna %q", jid)
+		c.QuoteReplyT("six.sender_failed", jid)
 		return fmt.Errorf("unable to resolve sender jid: %s", jid)
 	}
-	if jid.Server != types.HiddenUserServer {
-		c.QuoteReply("Lakukan di private chat.")
-		return nil
-	}
 
-	args := c.Parser.Args
-	if len(args) == 1 {
+	action, ok := argutil.Get[string](c.Args, "action")
+	if !ok {
 		return reminderList(c)
 	}
+	args := argutil.GetAll[string](c.Args, "args")
+
+	switch strings.ToLower(action) {
+	case "remove", "rm":
+		return reminderRemove(c, args)
+	case "pause":
+		return reminderPause(c, args)
+	case "mute":
+		return reminderMute(c)
+	case "resume":
+		return reminderResume(c)
+	}
 
-	classCtx := args[1].Content.Data
-	classCode, classNum, err := parseClassCtx(classCtx)
+	class, err := argutil.ParseClassCode(action)
 	if err != nil {
-		c.QuoteReply("Format kelas salah: %s. Contoh yang benar: `ET1201-01`", err)
+		c.QuoteReplyT("six.bad_code", action, err)
 		return nil
 	}
+	classCode, classNum := class.Code, class.Number
 
 	offset := 0
 	anchorAtEnd := false
-	if len(args) == 3 {
-		offsetStr := args[2].Content.Data
-		offset, anchorAtEnd, err = parseOffset(offsetStr)
+	if len(args) > 0 {
+		offset, anchorAtEnd, err = parseOffset(args[0])
 		if err != nil {
-			c.QuoteReply("Format offset salah: %s. Contoh yang benar: `+10`, `^-20`, `-30m`, `^`", err)
+			c.QuoteReplyT("six.reminder.bad_offset", err)
 			return nil
 		}
 		if offset > OFFSET_MAX || offset < -OFFSET_MAX {
-			c.QuoteReply(
-				"Nilai offset terlalu besar atau kecil. Paling kecil -1 pekan (-%d menit) dan paling besar 1 pekan (%d menit). Didapat: %d menit",
-				OFFSET_MAX, OFFSET_MAX, offset,
-			)
+			c.QuoteReplyT("six.reminder.offset_range", OFFSET_MAX, OFFSET_MAX, offset)
 			return nil
 		}
 	}
@@ -70,10 +76,10 @@ func reminderHandler(c *messageutil.MessageContext) error {
 	tx := stmt.First(&foundSubjectClass)
 	if err = tx.Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			c.QuoteReply("Tidak dapat menemukan matkul %s kelas %02d. Jika ini merupakan kesalahan, coba hubungi pemilik bot.", classCode, classNum)
+			c.QuoteReplyT("six.class_not_found", classCode, classNum)
 			return nil
 		} else {
-			c.QuoteReply("Gagal mengambil ID kelas: Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s", err)
+			c.QuoteReplyT("six.reminder.class_failed", err)
 			return err
 		}
 	}
@@ -93,37 +99,38 @@ func reminderHandler(c *messageutil.MessageContext) error {
 		Attrs(toInsert).
 		FirstOrCreate(&toInsert)
 	if tx.Error != nil {
-		c.QuoteReply("Gagal menambahkan reminder kelas: Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s", tx.Error)
+		c.QuoteReplyT("six.reminder.add_failed", tx.Error)
 		return tx.Error
 	}
 
-	ctx := fmt.Sprintf("%d menit %s kelas %s-%02d (%s) %s", abs(offset), afterBefore(offset), classCode, classNum, foundSubjectClass.Subject.Name, anchor(anchorAtEnd))
+	ctx := c.T("six.reminder.describe", abs(offset), c.T(afterBefore(offset)), classCode, classNum, foundSubjectClass.Subject.Name, c.T(anchor(anchorAtEnd)))
 
 	if tx.RowsAffected == 0 {
-		c.QuoteReply("Pengingat %q sudah pernah ditambahkan.", ctx)
+		c.QuoteReplyT("six.reminder.exists", ctx)
 	} else {
-		c.QuoteReply("Berhasil menambahkan pengingat %q.", ctx)
+		c.QuoteReplyT("six.reminder.added", ctx)
 	}
 
 	return nil
 }
 
 func reminderList(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
 	jid := c.GetChat()
 	found, err := gorm.G[models.ClassReminder](db).
 		Joins(clause.InnerJoin.Association("SubjectClass.Subject"), models.NoopJoin).
 		Where("jid = ?", jid).
 		Order("subject_class_id").
 		Order("offset_minutes").
-		Find(context.Background())
+		Find(c.Context())
 	if err != nil {
-		c.QuoteReply("Gagal mengambil data reminder, harap laporkan ke pemilik bot.\nInformasi tambahan: `%s`", err)
+		c.QuoteReplyT("six.reminder.list_failed", err)
 		return err
 	}
 
 	theCmd := fmt.Sprintf("%s%s", c.Parser.Command.UsedPrefix, c.Parser.Command.Name.Data)
 	if len(found) == 0 {
-		c.QuoteReply("Tidak ada reminder yang diatur. Lihat `%s help reminder` untuk informasi lebih lanjut.", theCmd)
+		c.QuoteReplyT("six.reminder.empty", theCmd)
 		return nil
 	}
 
@@ -138,19 +145,13 @@ func reminderList(c *messageutil.MessageContext) error {
 		}
 
 		dur := time.Duration(f.OffsetMinutes) * time.Minute
-		kapan := ""
+		var kapan i18n.MessageID
 		if dur < 0 {
-			kapan = "sebelum"
+			kapan = "six.reminder.before"
 		} else if dur > 0 {
-			kapan = "setelah"
+			kapan = "six.reminder.after"
 		} else {
-			kapan = "tepat saat"
-		}
-		ref := ""
-		if f.AnchorAtEnd {
-			ref = "berakhir"
-		} else {
-			ref = "dimulai"
+			kapan = "six.reminder.exactly_at"
 		}
 
 		dur = dur.Abs()
@@ -159,23 +160,23 @@ func reminderList(c *messageutil.MessageContext) error {
 		menit := int(math.Floor(dur.Minutes())) % 60
 
 		if hari > 0 {
-			fmt.Fprintf(builders[id], "%d hari ", hari)
+			builders[id].WriteString(c.T("six.reminder.days", hari))
 		}
 		if jam > 0 {
-			fmt.Fprintf(builders[id], "%d jam ", jam)
+			builders[id].WriteString(c.T("six.reminder.hours", jam))
 		}
 		if menit > 0 {
-			fmt.Fprintf(builders[id], "%d menit ", menit)
+			builders[id].WriteString(c.T("six.reminder.minutes", menit))
 		}
 
-		fmt.Fprintf(builders[id], "%s kelas %s",
-			kapan,
-			ref,
-		)
+		builders[id].WriteString(c.T("six.reminder.list_item", c.T(kapan), c.T(anchor(f.AnchorAtEnd))))
 	}
 
 	var msg strings.Builder
-	fmt.Fprintln(&msg, "Daftar reminder:")
+	if status := reminderStatus(c); status != "" {
+		fmt.Fprintln(&msg, status)
+	}
+	fmt.Fprintln(&msg, c.T("six.reminder.list_header"))
 	fmt.Fprintln(&msg, "")
 	for _, builder := range builders {
 		fmt.Fprintf(&msg, "%s", builder.String())
@@ -186,21 +187,21 @@ func reminderList(c *messageutil.MessageContext) error {
 	return nil
 }
 
-func afterBefore(offset int) string {
+func afterBefore(offset int) i18n.MessageID {
 	if offset < 0 {
-		return "sebelum"
+		return "six.reminder.before"
 	} else if offset > 0 {
-		return "setelah"
+		return "six.reminder.after"
 	} else {
-		return "saat"
+		return "six.reminder.at"
 	}
 }
 
-func anchor(anchorAtEnd bool) string {
+func anchor(anchorAtEnd bool) i18n.MessageID {
 	if anchorAtEnd {
-		return "berakhir"
+		return "six.reminder.ends"
 	} else {
-		return "dimulai"
+		return "six.reminder.starts"
 	}
 }
 
@@ -0,0 +1,180 @@
+package six
+
+import (
+	"errors"
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/database/models"
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/datetime"
+	"kano/internal/utils/messageutil"
+	"kano/internal/utils/six/schedules"
+	"kano/internal/utils/six/timetable"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"gorm.io/gorm"
+)
+
+func JadwalHandler(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
+	jid := c.GetChat()
+	if jid.Server == types.DefaultUserServer {
+		c.QuoteReplyT("six.sender_failed", jid)
+		return fmt.Errorf("unable to resolve sender jid: %s", jid)
+	}
+
+	loc := config.GetConfig().Timezone
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	days := 1
+
+	when, _ := argutil.Get[string](c.Args, "when")
+	switch strings.ToLower(when) {
+	case "", "today":
+	case "week":
+		days = 7
+	default:
+		date, ok := datetime.ParseDate(when, loc)
+		if !ok {
+			c.QuoteReplyT("six.jadwal.bad_when", when)
+			return nil
+		}
+		from = date
+	}
+	to := from.AddDate(0, 0, days)
+
+	var follows int64
+	tx := db.Model(&models.ClassFollower{}).Where("jid = ?", jid).Count(&follows)
+	if tx.Error != nil {
+		c.QuoteReplyT("six.internal_error", tx.Error)
+		return tx.Error
+	}
+	if follows == 0 {
+		c.QuoteReplyT("six.follow.empty", c.Parser.Command.UsedPrefix)
+		return nil
+	}
+
+	var entries []models.ClassSchedule
+	tx = db.
+		Preload("Rooms").
+		Preload("SubjectClass.Subject").
+		Where("subject_class_id IN (?)", db.Model(&models.ClassFollower{}).Select("subject_class_id").Where("jid = ?", jid)).
+		Where("start >= ? AND start < ?", from, to).
+		Order("start").
+		Find(&entries)
+	if tx.Error != nil {
+		c.QuoteReplyT("six.internal_error", tx.Error)
+		return tx.Error
+	}
+
+	var builder strings.Builder
+	if days == 1 {
+		if len(entries) == 0 {
+			c.QuoteReplyT("six.jadwal.empty_day", schedules.RenderDate(c.Lang(), from, loc))
+			return nil
+		}
+		builder.WriteString(c.T("six.jadwal.day", schedules.RenderDate(c.Lang(), from, loc)))
+		for _, entry := range entries {
+			fmt.Fprintf(&builder, "\n- %s", schedules.RenderTimetableEntry(c.Lang(), entry, loc))
+		}
+	} else {
+		last := to.AddDate(0, 0, -1)
+		if len(entries) == 0 {
+			c.QuoteReplyT("six.jadwal.empty_week", schedules.RenderDate(c.Lang(), from, loc), schedules.RenderDate(c.Lang(), last, loc))
+			return nil
+		}
+		builder.WriteString(c.T("six.jadwal.week", schedules.RenderDate(c.Lang(), from, loc), schedules.RenderDate(c.Lang(), last, loc)))
+
+		lastDay := -1
+		for _, entry := range entries {
+			if day := entry.Start.In(loc).YearDay(); day != lastDay {
+				fmt.Fprintf(&builder, "\n\n*%s*", schedules.RenderDate(c.Lang(), entry.Start, loc))
+				lastDay = day
+			}
+			fmt.Fprintf(&builder, "\n- %s", schedules.RenderTimetableEntry(c.Lang(), entry, loc))
+		}
+	}
+
+	c.QuoteReply("%s", builder.String())
+	return nil
+}
+
+func CekHandler(c *messageutil.MessageContext) error {
+	db := db.WithContext(c.Context())
+	loc := config.GetConfig().Timezone
+
+	classes := []models.SubjectClass{}
+	for _, code := range argutil.ParseClassCodes(argutil.GetAll[string](c.Args, "class_code")) {
+		if code.Err != nil {
+			c.QuoteReplyT("six.bad_code", code.Raw, code.Err)
+			return nil
+		}
+		class := code.Class
+
+		found, err := findClass(db, class)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				c.QuoteReplyT("six.class_not_found", class.Code, class.Number)
+				return nil
+			}
+			c.QuoteReplyT("six.internal_error", err)
+			return err
+		}
+		classes = append(classes, found)
+	}
+	if len(classes) < 2 {
+		c.QuoteReplyT("six.cek.need_more", c.Parser.Command.UsedPrefix)
+		return nil
+	}
+
+	ids := make([]uint, len(classes))
+	labels := make(map[uint]string, len(classes))
+	for i, class := range classes {
+		ids[i] = class.ID
+		labels[class.ID] = fmt.Sprintf("%s-%02d", class.Subject.Code, class.Number)
+	}
+
+	var entries []models.ClassSchedule
+	tx := db.Where("subject_class_id IN ?", ids).Order("unix_start").Find(&entries)
+	if tx.Error != nil {
+		c.QuoteReplyT("six.internal_error", tx.Error)
+		return tx.Error
+	}
+
+	scheduled := map[uint]bool{}
+	meetings := make([]timetable.Entry, len(entries))
+	for i, entry := range entries {
+		scheduled[entry.SubjectClassID] = true
+		meetings[i] = timetable.Entry{Class: entry.SubjectClassID, Start: entry.Start, End: entry.End}
+	}
+	conflicts := timetable.Conflicts(ids, meetings)
+
+	var lines []string
+	for _, conflict := range conflicts {
+		lines = append(lines, c.T("six.cek.conflict", labels[conflict.A], labels[conflict.B], conflict.Count, schedules.RenderTimeRange(c.Lang(), conflict.Start, conflict.End, loc)))
+	}
+	for _, id := range ids {
+		if !scheduled[id] {
+			lines = append(lines, c.T("six.cek.no_schedule", labels[id]))
+		}
+	}
+
+	if len(conflicts) == 0 {
+		names := make([]string, len(ids))
+		for i, id := range ids {
+			names[i] = labels[id]
+		}
+		header := c.T("six.cek.none", strings.Join(names, ", "))
+		if len(lines) == 0 {
+			c.QuoteReply("%s", header)
+		} else {
+			c.QuoteReply("%s\n\n- %s", header, strings.Join(lines, "\n- "))
+		}
+		return nil
+	}
+
+	c.QuoteReply("%s\n\n- %s", c.T("six.cek.found", len(conflicts)), strings.Join(lines, "\n- "))
+	return nil
+}
@@ -1,20 +1,21 @@
 package six
 
 import (
-	"kano/internal/config"
+	"fmt"
 	"kano/internal/cronjobs"
 	"kano/internal/utils/messageutil"
 	"kano/internal/utils/six/fetcher"
+	"kano/internal/worker"
 	"os"
 	"path"
+	"time"
 )
 
-func updateHandler(c *messageutil.MessageContext) error {
-	if !c.IsSenderSame(config.GetConfig().OwnerJID) {
-		c.QuoteReply("Perintah ini hanya bisa dieksekusi oleh pemilik bot.")
-		return nil
-	}
+// A full update easily outlives the handler timeout, stopping halfway would
+// leave the diff partly applied
+const updateTimeout = time.Hour
 
+func UpdateHandler(c *messageutil.MessageContext) error {
 	args := c.Parser.Args
 	if len(args) > 1 {
 		kh := args[1].Content.Data
@@ -22,6 +23,9 @@ func updateHandler(c *messageutil.MessageContext) error {
 	}
 
 	fetcher.ResetCookie()
-	cronjobs.SixUpdateSchedules(c.Client.GetClient())()
+	// Progress goes to the owner, same as the cron job
+	if !worker.Go(updateTimeout, cronjobs.SixUpdateSchedules(c.Client.GetClient())) {
+		return fmt.Errorf("update not started, shutting down")
+	}
 	return nil
 }
@@ -0,0 +1,63 @@
+package handles
+
+import (
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/messageutil"
+	"kano/internal/utils/usage"
+	"strings"
+	"time"
+)
+
+const (
+	statsDefaultWindow = 24 * time.Hour
+	statsLimit         = 15
+)
+
+func StatsHandler(c *messageutil.MessageContext) error {
+	window, ok := argutil.Get[time.Duration](c.Args, "window")
+	if !ok {
+		window = statsDefaultWindow
+	}
+	if window <= 0 {
+		c.QuoteReplyT("stats.bad_window")
+		return nil
+	}
+
+	stats, err := usage.Summary(time.Now().Add(-window), statsLimit)
+	if err != nil {
+		c.QuoteReplyT("stats.failed", err)
+		return err
+	}
+	if len(stats) == 0 {
+		c.QuoteReplyT("stats.empty", window)
+		return nil
+	}
+
+	var msg strings.Builder
+	msg.WriteString(c.T("stats.header", window))
+	for i, s := range stats {
+		p95 := "-"
+		if s.P95Ms.Valid {
+			p95 = (time.Duration(s.P95Ms.Float64) * time.Millisecond).String()
+		}
+		msg.WriteString("\n" + c.T("stats.entry", i+1, s.Command, s.Total, s.ErrorRate()*100, p95))
+	}
+
+	c.QuoteReply("%s", msg.String())
+	return nil
+}
+
+var StatsMan = CommandMan{
+	Name: "stats - command usage stats",
+	Synopsis: []string{
+		"*stats* [ _window_ ]",
+	},
+	Description: []string{
+		"Shows the most used commands with their error rate and 95th percentile latency. Denied, rate limited and invalid calls count towards the total but not the latency. Can only be executed by the owner of the bot.",
+		"_window_" +
+			"\n{SPACE}Optional. How far back to look, defaults to 24 hours. A bare number is taken as minutes, units *d*, *h* and *m* can be combined." +
+			"\n{SPACE}Example: `90` => 90 minutes, `7d` => 7 days, `1d12h` => 36 hours.",
+	},
+	SourceFilename: "stats.go",
+	SeeAlso:        []SeeAlso{},
+}
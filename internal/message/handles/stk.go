@@ -62,27 +62,27 @@ func Stk(c *messageutil.MessageContext) error {
 	}
 
 	if download == nil {
-		c.QuoteReply("Given message is not a media. Don't do that again.")
+		c.QuoteReplyT("stk.not_media")
 		log.Debugf("Given message is not a media")
 		return nil
 	}
 
 	if isViewOnce {
-		c.QuoteReply("Given media is a view once. Don't do that again.")
+		c.QuoteReplyT("stk.view_once")
 		log.Debugf("Given media is a view once")
 		return nil
 	}
 
 	err := c.ValidateDownloadableMessage(download)
 	if err != nil {
-		c.QuoteReply("Given media is not downloadable, please resend it.\nDebug info: %s", err.Error())
+		c.QuoteReplyT("stk.not_downloadable", err.Error())
 		log.Infof("Given media is not downloadable: %s", err.Error())
 		return nil
 	}
 
 	downloadedBytes, err := c.Client.Download(download)
 	if err != nil {
-		c.QuoteReply("Unable to download media. Please resend it.\nDebug info: %s", err.Error())
+		c.QuoteReplyT("stk.download_failed", err.Error())
 		log.Warnf("Unable to download media: %s", err.Error())
 		return nil
 	}
@@ -102,7 +102,7 @@ func Stk(c *messageutil.MessageContext) error {
 		StickerPackPublisher: publisher,
 	}, isAnimated)
 	if err != nil {
-		c.QuoteReply("Failed to convert media into sticker. This is an internal error, try again later.\nDebug info: %s", err.Error())
+		c.QuoteReplyT("stk.convert_failed", err.Error())
 		log.Errorf("Sticker creation failed: %s", err.Error())
 		return nil
 	}
@@ -10,6 +10,7 @@ import (
 	"image/color"
 	"io"
 	"kano/internal/config"
+	"kano/internal/utils/httpclient"
 	"kano/internal/utils/image/png"
 	"kano/internal/utils/messageutil"
 	"kano/internal/utils/sticker"
@@ -17,6 +18,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	imagepng "image/png"
 	imageutil "kano/internal/utils/image"
@@ -39,62 +41,75 @@ type LineStickerData struct {
 	SoundUrl          string `json:"soundUrl,omitempty"`
 }
 
+// Stickers are fetched one by one from the same CDN
+var stklineClient = httpclient.New(httpclient.Options{
+	Timeout:      time.Minute,
+	MaxRetries:   3,
+	BaseDelay:    time.Second,
+	MaxDelay:     15 * time.Second,
+	MaxBodyBytes: 16 << 20,
+	PerHost:      2,
+})
+
 func StkLineHandler(c *messageutil.MessageContext) error {
 	args := c.Parser.Args
 	if len(args) == 0 {
-		c.QuoteReply("Give the sticker store url")
+		c.QuoteReplyT("stkline.no_url")
 		return nil
 	}
 
 	givenUrl := args[0].Content.Data
 	u, err := url.Parse(givenUrl)
 	if err != nil {
-		c.QuoteReply("Given url is not parsable")
+		c.QuoteReplyT("stkline.bad_url")
 		return nil
 	}
 
 	if u.Scheme != "https" {
-		c.QuoteReply(`Given url scheme is not "https"`)
+		c.QuoteReplyT("stkline.bad_scheme")
 		return nil
 	}
 	if u.Host != "store.line.me" {
-		c.QuoteReply(`Given url host is not "store.line.me"`)
+		c.QuoteReplyT("stkline.bad_host")
 		return nil
 	}
 	paths := strings.Split(u.Path, "/")
 	if len(paths) <= 1 {
-		c.QuoteReply("Given url is the store home")
+		c.QuoteReplyT("stkline.store_home")
 		return nil
 	}
 	if paths[1] != "stickershop" && paths[1] != "emojishop" {
-		c.QuoteReply("Shop type is unsupported, ensure the given url is a stickershop or emojishop. Got: %s", paths[1])
+		c.QuoteReplyT("stkline.bad_shop", paths[1])
 		return nil
 	}
 
 	itemType := strings.Replace(paths[1], "shop", "", 1)
 	if len(paths) < 4 {
-		c.QuoteReply("Unable to get sticker/emoji id, is your given url valid?")
+		c.QuoteReplyT("stkline.no_id")
 		return nil
 	}
 	itemId := paths[3]
 
-	req, _ := http.NewRequest("GET", givenUrl, nil)
-	req.Header.Set("User-Agent", config.USER_AGENT)
-	cli := http.Client{}
-	resp, err := cli.Do(req)
+	req, _ := http.NewRequestWithContext(c.Context(), "GET", givenUrl, nil)
+	req.Header.Set("User-Agent", config.GetConfig().UserAgent)
+	resp, err := stklineClient.Do(req)
 	if err != nil {
-		c.QuoteReply("Failed to fetch store page")
+		c.QuoteReplyT("stkline.fetch_failed")
 		return nil
 	}
 	defer resp.Body.Close()
 
 	page, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		c.QuoteReplyT("stkline.read_failed", err)
+		return nil
+	}
 	stickerTitle := strings.TrimSpace(page.Find(`[data-test="sticker-name-title"]`).Text())
 	if stickerTitle == "" {
 		stickerTitle = strings.TrimSpace(page.Find(`[data-test="emoji-name-title"]`).Text())
 	}
 	if stickerTitle == "" {
-		c.QuoteReply("Failed to get sticker/emoji name")
+		c.QuoteReplyT("stkline.no_name")
 		return nil
 	}
 
@@ -103,7 +118,7 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 		stickerAuthor = strings.TrimSpace(page.Find(`[data-test="emoji-author"]`).Text())
 	}
 	if stickerAuthor == "" {
-		c.QuoteReply("Failed to get sticker/emoji author")
+		c.QuoteReplyT("stkline.no_author")
 		return nil
 	}
 
@@ -137,57 +152,57 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 	// Cover image
 	cover := page.Find(`[ref="mainImage"]`)
 	if cover.Length() != 1 {
-		c.QuoteReply("Unable to find sticker/emoji cover: expected 1, got %d", cover.Length())
+		c.QuoteReplyT("stkline.cover_count", cover.Length())
 		return nil
 	}
 	rawCoverData, ok := cover.Attr("data-preview")
 	if !ok {
-		c.QuoteReply("Unable to find sticker/emoji cover: cannot get data-preview attribute")
+		c.QuoteReplyT("stkline.cover_no_preview")
 		return nil
 	}
 	rawCoverData = strings.ReplaceAll(rawCoverData, "&quot;", `"`)
 	var coverData LineStickerData
 	err = json.Unmarshal([]byte(rawCoverData), &coverData)
 	if !ok {
-		c.QuoteReply("Unable to find sticker/emoji cover: invalid data")
+		c.QuoteReplyT("stkline.cover_invalid")
 		return nil
 	}
 
-	req2, _ := http.NewRequest("GET", coverData.StaticUrl, nil)
-	req2.Header.Set("User-Agent", config.USER_AGENT)
-	resp, err = cli.Do(req2)
+	req2, _ := http.NewRequestWithContext(c.Context(), "GET", coverData.StaticUrl, nil)
+	req2.Header.Set("User-Agent", config.GetConfig().UserAgent)
+	resp, err = stklineClient.Do(req2)
 	if err != nil {
-		c.QuoteReply("Unable to find sticker/emoji cover: failed to fetch cover image: %s", err)
+		c.QuoteReplyT("stkline.cover_fetch_failed", err)
 		return nil
 	}
 	defer resp.Body.Close()
 	pngImg, err := imagepng.Decode(resp.Body)
 	if err != nil {
-		c.QuoteReply("Unable to find sticker/emoji cover: failed to read cover image as png: %s", err)
+		c.QuoteReplyT("stkline.cover_png_failed", err)
 		return nil
 	}
 	var res bytes.Buffer
 	err = webp.Encode(&res, pngImg, nil)
 	if err != nil {
-		c.QuoteReply("Unable to find sticker/emoji cover: failed to convert cover image to webp: %s", err)
+		c.QuoteReplyT("stkline.cover_webp_failed", err)
 		return nil
 	}
 	err = addFile(stickerPackId+".webp", res.Bytes())
 	if err != nil {
-		c.QuoteReply("Failed to add cover image to the zip: %s", err)
+		c.QuoteReplyT("stkline.cover_zip_failed", err)
 		return nil
 	}
 	*stkPackMsg.StickerPackSize += uint64(res.Len())
 
 	// All stickers
 	previews := page.Find(".FnStickerPreviewItem")
-	c.QuoteReply("Sticker name: %q\nStickers found: %d\n\nPlease wait...", stickerTitle, previews.Length())
+	c.QuoteReplyT("stkline.found", stickerTitle, previews.Length())
 	stkPackMsg.Stickers = make([]*waE2E.StickerPackMessage_Sticker, previews.Length())
 
 	for i, preview := range previews.EachIter() {
 		data, ok := preview.Attr("data-preview")
 		if !ok {
-			c.QuoteReply("Failed to get sticker/emoji data at index %d", i)
+			c.QuoteReplyT("stkline.item_missing", i)
 			return nil
 		}
 		data = strings.ReplaceAll(data, "&quot;", "\"")
@@ -195,7 +210,7 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 		var parsed LineStickerData
 		err = json.Unmarshal([]byte(data), &parsed)
 		if err != nil {
-			c.QuoteReply("Failed to parse sticker/emoji data at index %d: %s", i, err)
+			c.QuoteReplyT("stkline.item_parse_failed", i, err)
 			return nil
 		}
 
@@ -206,15 +221,21 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 			stkUrl = parsed.AnimationUrl
 		}
 		if stkUrl == "" {
-			c.QuoteReply("Unexpected empty sticker/emoji url at index %d", i)
+			c.QuoteReplyT("stkline.item_no_url", i)
 			return nil
 		}
 
-		req, _ := http.NewRequest("GET", stkUrl, nil)
-		req.Header.Set("User-Agent", config.USER_AGENT)
-		resp, err := cli.Do(req)
+		req, _ := http.NewRequestWithContext(c.Context(), "GET", stkUrl, nil)
+		req.Header.Set("User-Agent", config.GetConfig().UserAgent)
+		resp, err := stklineClient.Do(req)
+		if err != nil {
+			c.QuoteReplyT("stkline.item_download_failed", i)
+			return err
+		}
+		imgBytes, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
 		if err != nil {
-			c.QuoteReply("Failed to download the sticker/emoji at index %d", i)
+			c.QuoteReplyT("stkline.item_download_error", i, err)
 			return err
 		}
 
@@ -225,16 +246,15 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 		}
 
 		var stkBytes []byte
-		imgBytes, _ := io.ReadAll(resp.Body)
 		if isAnimated {
 			a, err := apng.DecodeAll(bytes.NewBuffer(imgBytes))
 			if err != nil {
-				c.QuoteReply("Failed to read sticker/emoji as animated PNG file: %s", err)
+				c.QuoteReplyT("stkline.apng_failed", err)
 				return err
 			}
 			header, err := png.GetHeader(imgBytes)
 			if err != nil {
-				c.QuoteReply("Failed to get PNG header: %s", err)
+				c.QuoteReplyT("stkline.png_header_failed", err)
 				return err
 			}
 			rendered := png.RenderAPNGFrames(a.Frames, int(header.Width), int(header.Height))
@@ -252,12 +272,12 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 				var w bytes.Buffer
 				err = webp.Encode(&w, render.Image, nil)
 				if err != nil {
-					c.QuoteReply("Failed to encode as webp: %s", err)
+					c.QuoteReplyT("stkline.webp_encode_failed", err)
 					return err
 				}
 				chunk, err := imageutil.ExtractChunksFromWebP(w.Bytes())
 				if err != nil {
-					c.QuoteReply("Failed to modify the webp: %s", err)
+					c.QuoteReplyT("stkline.webp_modify_failed", err)
 					return err
 				}
 				webpChunk.ANMF[i].BlendingMethod = true
@@ -275,25 +295,25 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 
 			stkBytes, err = imageutil.BuildWebPFromChunks(webpChunk)
 			if err != nil {
-				c.QuoteReply("Failed to build webp after modification: %s", err)
+				c.QuoteReplyT("stkline.webp_build_failed", err)
 				return err
 			}
 
 			stkBytes, err = imageutil.FixRIFFHeader(stkBytes)
 			if err != nil {
-				c.QuoteReply("Failed: %s", err)
+				c.QuoteReplyT("stkline.failed", err)
 				return err
 			}
 
 			stkBytes, err = sticker.AppendMetadataToSticker(stkBytes, metadata)
 			if err != nil {
-				c.QuoteReply("Failed to append metadata: %s", err)
+				c.QuoteReplyT("stkline.metadata_failed", err)
 				return err
 			}
 		} else {
 			stkBytes, err = sticker.MakeSticker(imgBytes, metadata, false)
 			if err != nil {
-				c.QuoteReply("Failed to make sticker: %s", err)
+				c.QuoteReplyT("stkline.sticker_failed", err)
 				return err
 			}
 		}
@@ -305,7 +325,7 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 
 		err = addFile(name, stkBytes)
 		if err != nil {
-			c.QuoteReply("Failed to add sticker/emoji to a zip: %s", err)
+			c.QuoteReplyT("stkline.zip_failed", err)
 			return err
 		}
 		*stkPackMsg.StickerPackSize += uint64(len(stkBytes))
@@ -327,7 +347,7 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 
 	upResp, err := c.Client.Upload(zipBytes.Bytes(), whatsmeow.MediaStickerPack)
 	if err != nil {
-		c.QuoteReply("Failed to upload sticker pack into the server: %s", err)
+		c.QuoteReplyT("stkline.upload_failed", err)
 		return err
 	}
 
@@ -344,3 +364,20 @@ func StkLineHandler(c *messageutil.MessageContext) error {
 
 	return nil
 }
+
+var StkLineMan = CommandMan{
+	Name: "stkline - import a LINE sticker pack",
+	Synopsis: []string{
+		"*stkline* _url_",
+	},
+	Description: []string{
+		"Downloads every sticker or emoji of a LINE store pack and sends them as a WhatsApp sticker pack. Animated stickers stay animated.",
+		"_url_" +
+			"\n{SPACE}Link to a stickershop or emojishop page on store.line.me." +
+			"\n{SPACE}Example: `https://store.line.me/stickershop/product/1234/en`.",
+	},
+	SourceFilename: "stkline.go",
+	SeeAlso: []SeeAlso{
+		{"stk", SeeAlsoTypeCommand},
+	},
+}
@@ -0,0 +1,56 @@
+package handles
+
+import (
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/chatutil/grouputil"
+	"kano/internal/utils/messageutil"
+	"slices"
+	"strings"
+)
+
+// A nested verb of a command, e.g. `follow` in `.six follow`
+type Subcommand struct {
+	Name    string
+	Aliases []string
+	Func    CommandHandlerFunc
+	Man     CommandMan
+	// Positional arguments are counted after the subcommand name
+	Args argutil.Schema
+
+	Permission CommandPermission
+	Scope      CommandScope
+}
+
+// Kept as a slice so help lists the subcommands in the declared order
+type SubcommandList []Subcommand
+
+func (l SubcommandList) Find(name string) (Subcommand, bool) {
+	name = strings.ToLower(name)
+	for _, sub := range l {
+		if sub.Name == name || slices.Contains(sub.Aliases, name) {
+			return sub, true
+		}
+	}
+	return Subcommand{}, false
+}
+
+// Finds the subcommand named by the first argument, if any
+func (h CommandHandler) findSubcommand(c *messageutil.MessageContext) (Subcommand, bool) {
+	if len(h.Subcommands) == 0 || len(c.Parser.Args) == 0 {
+		return Subcommand{}, false
+	}
+	return h.Subcommands.Find(c.Parser.Args[0].Content.Data)
+}
+
+func (s Subcommand) checkAccess(c *messageutil.MessageContext) bool {
+	return checkAccess(c, s.Permission, s.Scope, grouputil.Feature{})
+}
+
+// Aliases prefixed with the parent command name, e.g. "six f"
+func (s Subcommand) fullAliases(parent string) []string {
+	aliases := make([]string, len(s.Aliases))
+	for i, a := range s.Aliases {
+		aliases[i] = parent + " " + a
+	}
+	return aliases
+}
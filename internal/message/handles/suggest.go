@@ -0,0 +1,63 @@
+package handles
+
+import (
+	"fmt"
+	"kano/internal/utils/messageutil"
+	"kano/internal/utils/ratelimit"
+	"kano/internal/utils/word"
+	"time"
+)
+
+var suggestRateLimit = ratelimit.Rule{Limit: 1, Window: 30 * time.Second}
+
+// Finds the closest command name or alias to the given unknown command.
+// Short names only tolerate one typo, longer ones tolerate two.
+func suggestCommand(name string) (string, bool) {
+	if len(name) < 3 {
+		return "", false
+	}
+
+	maxDistance := 1
+	if len(name) > 4 {
+		maxDistance = 2
+	}
+
+	best := ""
+	bestDistance := maxDistance + 1
+	for candidate := range commandNames {
+		if len(candidate) < 2 {
+			continue
+		}
+		d := word.Distance(name, candidate)
+		// Ties go to the alphabetically first candidate, so the answer is stable
+		if d < bestDistance || (d == bestDistance && candidate < best) {
+			best = candidate
+			bestDistance = d
+		}
+	}
+
+	return best, bestDistance <= maxDistance
+}
+
+func replySuggestion(c *messageutil.MessageContext, name string) {
+	if isGroupChat(c) {
+		if c.Group == nil || c.Group.GroupSettings == nil || !c.Group.GroupSettings.IsEnabled(FeatureSuggest) {
+			return
+		}
+	}
+
+	suggestion, ok := suggestCommand(name)
+	if !ok {
+		return
+	}
+
+	key := fmt.Sprintf("suggest:%s", c.GetChat().String())
+	if c.Contact != nil {
+		key = fmt.Sprintf("suggest:%d", c.Contact.ID)
+	}
+	if wait, _ := limiter.Take(key, suggestRateLimit); wait != 0 {
+		return
+	}
+
+	c.QuoteReplyT("suggest.did_you_mean", name, c.Parser.Command.UsedPrefix, suggestion)
+}
@@ -1,6 +1,11 @@
 package handles
 
-import "kano/internal/utils/messageutil"
+import (
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/chatutil/grouputil"
+	"kano/internal/utils/messageutil"
+	"kano/internal/utils/ratelimit"
+)
 
 type CommandHandlerFunc func(ctx *messageutil.MessageContext) error
 
@@ -8,8 +13,64 @@ type CommandHandler struct {
 	Func    CommandHandlerFunc
 	Aliases []string
 	Man     CommandMan
+	// Section of the help index, uncategorized commands are listed last
+	Category CommandCategory
+
+	// Verbs dispatched on the first argument, Func handles everything else
+	Subcommands SubcommandList
+	// Validated before Func is called, the result is put in MessageContext.Args
+	Args argutil.Schema
+
+	// Who may run the command, checked before Func is called
+	Permission CommandPermission
+	// Where the command may run, checked before Func is called
+	Scope CommandScope
+	// Group feature that must be enabled, only checked in group chats
+	Feature grouputil.Feature
+
+	// Limits how often one contact may run the command
+	UserRateLimit ratelimit.Rule
+	// Limits how often the command may run in one group, by anyone
+	GroupRateLimit ratelimit.Rule
+}
+
+type CommandPermission uint8
+
+const (
+	PermissionAnyone CommandPermission = iota
+	// Admin or superadmin of the group. In private chats the sender is
+	// treated as the admin of their own chat.
+	PermissionGroupAdmin
+	PermissionOwner
+)
+
+type CommandCategory string
+
+const (
+	CategoryAcademic  CommandCategory = "academic"
+	CategoryGames     CommandCategory = "games"
+	CategoryMedia     CommandCategory = "media"
+	CategoryUtilities CommandCategory = "utilities"
+	CategoryAdmin     CommandCategory = "admin"
+)
+
+// In the order shown by help
+var Categories = []CommandCategory{
+	CategoryAcademic,
+	CategoryGames,
+	CategoryMedia,
+	CategoryUtilities,
+	CategoryAdmin,
 }
 
+type CommandScope uint8
+
+const (
+	ScopeAll CommandScope = iota
+	ScopeGroup
+	ScopePrivate
+)
+
 type CommandMan struct {
 	Name        string
 	Synopsis    []string
@@ -22,10 +22,10 @@ type downloadableMessageWithURL interface {
 }
 
 func Vo(c *messageutil.MessageContext) (err error) {
-	db := database.GetInstance()
+	db := database.GetInstance().WithContext(c.Context())
 	msgId, senderJid, repliedMsg := c.GetRepliedMessage()
 	if repliedMsg == nil {
-		_, err = c.QuoteReply("Please reply to a view-once message.")
+		_, err = c.QuoteReplyT("vo.no_reply")
 		return
 	}
 
@@ -38,16 +38,16 @@ func Vo(c *messageutil.MessageContext) (err error) {
 	if tx.Error != nil {
 		if !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
 			err = tx.Error
-			c.QuoteReply("Failed to query to vo_request table: %s", tx.Error)
+			c.QuoteReplyT("vo.query_failed", tx.Error)
 			return
 		}
 	} else {
-		msg := fmt.Sprintf("View-once message is already requested by @%s", found.RequesterJid.User)
+		msg := c.T("vo.already_requested", found.RequesterJid.User)
 		if found.Accepted.Valid {
 			if found.Accepted.Bool {
-				msg += " and already accepted by the sender"
+				msg = c.T("vo.already_accepted", found.RequesterJid.User)
 			} else {
-				msg += " and already denied by the sender"
+				msg = c.T("vo.already_denied", found.RequesterJid.User)
 			}
 		}
 
@@ -94,12 +94,12 @@ func Vo(c *messageutil.MessageContext) (err error) {
 	}
 
 	if downloadable == nil {
-		_, err = c.QuoteReply("Replied message is not a view-once message.")
+		_, err = c.QuoteReplyT("vo.not_view_once")
 		return
 	}
 
 	if val := c.ValidateDownloadableMessage(downloadable); val != nil {
-		_, err = c.QuoteReply("Replied view once has missing fields. Most likely you are using the latest WhatsApp version.\nDebug info: %s", val.Error())
+		_, err = c.QuoteReplyT("vo.missing_fields", val.Error())
 		return
 	}
 
@@ -140,7 +140,7 @@ func Vo(c *messageutil.MessageContext) (err error) {
 			insert.Accepted = sql.NullBool{Bool: true, Valid: true}
 		}
 	} else {
-		textMsg := fmt.Sprintf("Waiting for approval from @%s (react with ✅ to approve, ❌ or just leave it alone to reject)", senderJid.User)
+		textMsg := c.T("vo.waiting_approval", senderJid.User)
 		ctxInfo := c.BuildReplyContextInfo()
 		ctxInfo.MentionedJID = []string{senderJid.String()}
 		sent, err := c.SendMessage(&waE2E.Message{
@@ -160,7 +160,7 @@ func Vo(c *messageutil.MessageContext) (err error) {
 	tx = db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&insert)
 	if tx.Error != nil {
 		err = tx.Error
-		c.Reply(fmt.Sprintf("Failed to save into vo_request table: %s", tx.Error))
+		c.ReplyT("vo.save_failed", tx.Error)
 		return
 	}
 
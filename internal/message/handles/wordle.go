@@ -25,11 +25,7 @@ func filterString(str string) string {
 }
 
 func WordleHandler(c *messageutil.MessageContext) error {
-	if c.Group != nil && !c.Group.GroupSettings.IsGameAllowed {
-		c.Logger.Debugf("Game is not allowed in %s", c.Group.JID)
-		return nil
-	}
-
+	db := db.WithContext(c.Context())
 	now := time.Now().UTC()
 	nowStr := now.Format("02-01-2006")
 
@@ -46,11 +42,11 @@ func WordleHandler(c *messageutil.MessageContext) error {
 
 	if err := tx.Error; err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			c.QuoteReply("Failed to get user wordle: %s", err)
+			c.QuoteReplyT("wordle.get_failed", err)
 			return err
 		}
 
-		theWordle, err := wordle.RandomSelectWordle()
+		theWordle, err := wordle.RandomSelectWordle(c.Context())
 		if err != nil {
 			c.QuoteReply("%s", err)
 			return err
@@ -61,7 +57,7 @@ func WordleHandler(c *messageutil.MessageContext) error {
 		tx = db.Preload("Target").Create(&foundUserWordle)
 		err = tx.Error
 		if err != nil {
-			c.QuoteReply("Failed to set user wordle: %s", err)
+			c.QuoteReplyT("wordle.set_failed", err)
 			return err
 		}
 		foundUserWordle.Target = theWordle
@@ -81,9 +77,9 @@ func WordleHandler(c *messageutil.MessageContext) error {
 	foundUserWordle.Target = models.Wordle{} // Reset, so it won't overwrite "wordle" table at insert query
 
 	if lg > 0 && strings.ToUpper(foundUserWordle.Guesses[lg-1]) == target {
-		caption = fmt.Sprintf("Your wordle is correct for today (%s), please wait for %s", target, waitFor)
+		caption = c.T("wordle.done", target, waitFor)
 	} else if lg >= 6 {
-		caption = fmt.Sprintf("Your attempts is over, the answer is %s", target)
+		caption = c.T("wordle.out", target)
 	} else {
 		args := c.Parser.Args
 		if len(args) > 0 {
@@ -93,11 +89,11 @@ func WordleHandler(c *messageutil.MessageContext) error {
 			}
 
 			if len(guess) < 5 {
-				c.QuoteReply("Word length is too short")
+				c.QuoteReplyT("wordle.too_short")
 				return nil
 			} else {
-				if !wordle.IsWordExists(guess) {
-					c.QuoteReply("Word %q doesn't exists", guess)
+				if !wordle.IsWordExists(c.Context(), guess) {
+					c.QuoteReplyT("wordle.not_exists", guess)
 					return nil
 				}
 
@@ -105,40 +101,40 @@ func WordleHandler(c *messageutil.MessageContext) error {
 				foundUserWordle.Guesses = append(foundUserWordle.Guesses, guess)
 				tx := db.Model(&models.UserWordle{}).Where("id = ?", foundUserWordle.ID).Update("guesses", foundUserWordle.Guesses)
 				if tx.Error != nil {
-					c.QuoteReply("failed to save user wordle guesses: %s", tx.Error)
+					c.QuoteReplyT("wordle.save_failed", tx.Error)
 					return tx.Error
 				}
 
 				if guess == target {
 					switch lg {
 					case 1:
-						caption = "Impressive, done in just one guess"
+						caption = c.T("wordle.first_try")
 					case 6:
-						caption = "Your guess was just right, luckily it was right"
+						caption = c.T("wordle.last_try")
 					default:
-						caption = "Yeay, your guess was correct!"
+						caption = c.T("wordle.correct")
 					}
 				} else {
 					switch lg {
 					case 6:
-						caption = fmt.Sprintf("The guess is over, the answer is %s", target)
+						caption = c.T("wordle.over", target)
 					default:
-						caption = fmt.Sprintf("Yah, your guess is wrong, try again (%d/6)", lg)
+						caption = c.T("wordle.wrong", lg)
 					}
 				}
 			}
 		} else {
 			if len(foundUserWordle.Guesses) == 0 {
-				caption = "Send .wordle [YOUR_GUESS] to guess the word for today!"
+				caption = c.T("wordle.start", c.Parser.Command.UsedPrefix)
 			} else {
-				caption = "Send .wordle [YOUR_GUESS] to continue your guess!"
+				caption = c.T("wordle.continue", c.Parser.Command.UsedPrefix)
 			}
 		}
 	}
 
 	imgBytes, err := wordle.GenerateWordleImage(target, foundUserWordle.Guesses)
 	if err != nil {
-		c.QuoteReply("failed to generate wordle image: %s", err)
+		c.QuoteReplyT("wordle.image_failed", err)
 		return fmt.Errorf("failed to generate wordle image: %s", err)
 	}
 
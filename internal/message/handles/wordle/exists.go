@@ -1,6 +1,7 @@
 package wordle
 
 import (
+	"context"
 	"errors"
 	"kano/internal/database"
 	"kano/internal/database/models"
@@ -9,15 +10,15 @@ import (
 	"gorm.io/gorm"
 )
 
-func IsWordExists(word string) bool {
-	db := database.GetInstance()
+func IsWordExists(ctx context.Context, word string) bool {
+	db := database.GetInstance().WithContext(ctx)
 
 	found := models.Wordle{}
 	tx := db.Where("word = ?", word).First(&found)
 
 	if err := tx.Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			dict, _ := definition.FindDefinition(word)
+			dict, _ := definition.FindDefinition(ctx, word)
 			if dict != nil && len(dict.Results) != 0 {
 				found.Word = word
 				found.Point = calculateWordPoint(word)
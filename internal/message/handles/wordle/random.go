@@ -1,14 +1,15 @@
 package wordle
 
 import (
+	"context"
 	"fmt"
 	"kano/internal/database"
 	"kano/internal/database/models"
 	"math/rand"
 )
 
-func RandomSelectWordle() (models.Wordle, error) {
-	db := database.GetInstance()
+func RandomSelectWordle(ctx context.Context) (models.Wordle, error) {
+	db := database.GetInstance().WithContext(ctx)
 	var ids []uint
 	tx := db.Model(&models.Wordle{}).Select("id").Find(&ids)
 	if err := tx.Error; err != nil {
@@ -1,34 +1,50 @@
 package message
 
 import (
+	"context"
 	"fmt"
 	"kano/internal/config"
 	"kano/internal/message/handles"
 	"kano/internal/message/reaction"
+	"kano/internal/utils/blocklist"
 	"kano/internal/utils/messageutil"
 
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/types/events"
 )
 
-func Main(cli *whatsmeow.Client, evt *events.Message) error {
-	msgCtx := messageutil.CreateContext(cli, evt)
-	if msgCtx == nil {
-		return fmt.Errorf("msgCtx is nil, look for error logs related to messageutil.CreateContext")
-	}
+func Main(ctx context.Context, cli *whatsmeow.Client, evt *events.Message) error {
+	log := config.GetLogger().Sub("Message")
 
-	if config.GetConfig().OwnerOnlyMode {
-		if !msgCtx.IsSenderSame(config.GetConfig().OwnerJID) {
+	// Checked before building the context, which already hits the database
+	info := evt.Info
+	owner := config.GetConfig().OwnerJID.ToNonAD()
+	isOwner := !owner.IsEmpty() && (info.Sender.ToNonAD() == owner || info.SenderAlt.ToNonAD() == owner)
+	if config.GetConfig().OwnerOnlyMode && !isOwner {
+		return nil
+	}
+	if !isOwner {
+		entry, blocked, err := blocklist.IsBlocked(ctx, info.Sender, info.SenderAlt, info.Chat)
+		if err != nil {
+			// Better to answer a blocked contact than to ignore everyone
+			log.Errorf("Failed to load blocklist: %s", err)
+		} else if blocked {
+			log.Debugf("Ignoring message %s from %s in %s, blocked %s (%s)", info.ID, info.Sender, info.Chat, entry.JID, entry.Reason)
 			return nil
 		}
 	}
 
+	msgCtx := messageutil.CreateContext(ctx, cli, evt)
+	if msgCtx == nil {
+		return fmt.Errorf("msgCtx is nil, look for error logs related to messageutil.CreateContext")
+	}
+
 	if msgCtx.GetText() != "" {
-		return handles.Handle(msgCtx)
+		return dispatch(msgCtx, msgCtx.Parser.Command.Name.Data, handles.Handle)
 	}
 
 	if msgCtx.Message.GetReactionMessage() != nil {
-		return reaction.Main(msgCtx)
+		return dispatch(msgCtx, "reaction", reaction.Main)
 	}
 
 	return nil
@@ -1,24 +1,42 @@
 package reaction
 
 import (
+	"errors"
 	"kano/internal/database"
 	"kano/internal/utils/messageutil"
+	"kano/internal/utils/usage"
 )
 
 var db = database.GetInstance()
 
-func Main(c *messageutil.MessageContext) error {
-	err := VoReactApproval(c)
-	if err != nil {
-		c.Logger.Errorf("%s", err)
-	}
+// Every handler reports whether the reaction was meant for it
+var handlers = []func(*messageutil.MessageContext) (bool, error){
+	VoReactApproval,
+	SawitAcceptChallenge,
+	SixReminderSnooze,
+}
+
+func Main(c *messageutil.MessageContext) (err error) {
+	entry := usage.Start(c, "reaction", c.GetReaction())
+	outcome := usage.OutcomePanic
+	acted := false
+	defer func() {
+		// Most reactions are just people reacting, only record the ones we handled
+		if acted || outcome == usage.OutcomePanic {
+			entry.Finish(outcome, err)
+		}
+	}()
 
-	err = SawitAcceptChallenge(c)
-	if err != nil {
-		c.Logger.Errorf("%s", err)
+	var errs []error
+	for _, handler := range handlers {
+		ok, herr := handler(c)
+		if herr != nil {
+			c.Logger.Errorf("%s", herr)
+			errs = append(errs, herr)
+		}
+		acted = acted || ok || herr != nil
 	}
 
-	// Hmm, it returns the last err value, but idc tho
-	// Just read the logs
-	return err
+	outcome = usage.OutcomeOK
+	return errors.Join(errs...)
 }
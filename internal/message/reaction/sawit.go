@@ -2,8 +2,8 @@ package reaction
 
 import (
 	"errors"
-	"fmt"
 	"kano/internal/database/models"
+	"kano/internal/message/handles"
 	"kano/internal/message/handles/sawit"
 	"kano/internal/utils/messageutil"
 	"math/rand"
@@ -15,25 +15,27 @@ import (
 	"gorm.io/gorm"
 )
 
-func SawitAcceptChallenge(c *messageutil.MessageContext) error {
+// Reports whether the reaction accepted a pending sawit challenge
+func SawitAcceptChallenge(c *messageutil.MessageContext) (bool, error) {
+	db := db.WithContext(c.Context())
 	if c.Group == nil {
-		return nil
+		return false, nil
 	}
 
-	if !c.Group.GroupSettings.IsGameAllowed {
-		return nil
+	if c.Group.GroupSettings == nil || !c.Group.GroupSettings.IsEnabled(handles.FeatureGame) {
+		return false, nil
 	}
 
 	c.Logger.Debugf("Entered SawitAcceptChallenge")
 	if c.GetReaction() == "" {
 		c.Logger.Debugf("Reaction is empty, ignoring")
-		return nil
+		return false, nil
 	}
 
 	acceptorParticipantId, err := c.GetParticipantID()
 	if err != nil {
 		c.Logger.Errorf("%s", err)
-		return err
+		return false, err
 	}
 
 	r := rand.New(rand.NewSource(time.Now().UnixMilli()))
@@ -47,7 +49,7 @@ func SawitAcceptChallenge(c *messageutil.MessageContext) error {
 	reactedMsgJid, _ := types.ParseJID(part)
 	if !reactKey.GetFromMe() && !c.IsMe(reactedMsgJid) {
 		c.Logger.Debugf("Reacted message is not from me")
-		return nil
+		return false, nil
 	}
 
 	reactedId := reactKey.GetID()
@@ -56,40 +58,40 @@ func SawitAcceptChallenge(c *messageutil.MessageContext) error {
 	if tx.Error != nil {
 		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
 			c.Logger.Errorf("No sawit attack at this point")
-			return nil
+			return false, nil
 		}
 
 		c.Logger.Errorf("Failed to get sawit attack info: %s", tx.Error)
-		return tx.Error
+		return false, tx.Error
 	}
 
 	if sawitAttack.ParticipantId == acceptorParticipantId {
 		// Acceptor cannot be same as the challenger
 		c.Logger.Debugf("Acceptor is same as the challenger, skipping")
-		return nil
+		return false, nil
 	}
 
 	if sawitAttack.IsAttackerWin.Valid {
 		// Challenge already accepted
 		c.Logger.Debugf("Challenge is already accepted")
-		return nil
+		return false, nil
 	}
 
 	challengerSawit, err := sawit.GetParticipantSawit(sawitAttack.ParticipantId)
 	if err != nil {
 		c.Logger.Errorf("Failed to get challenger sawit: %s", err)
-		return err
+		return true, err
 	}
 	acceptorSawit, err := sawit.GetParticipantSawit(acceptorParticipantId)
 	if err != nil {
 		c.Logger.Errorf("Failed to get challenger sawit: %s", err)
-		return err
+		return true, err
 	}
 
 	if acceptorSawit.Height <= 0 {
-		msg := fmt.Sprintf("Dear, %s, your sawit height is negative, go pay your debt buddy 😭🙏", acceptorSawit.GetName())
+		msg := c.T("sawit.duel.negative", acceptorSawit.GetName())
 		if acceptorSawit.Height == 0 {
-			msg = fmt.Sprintf("Dear, %s, you don't have any sawit right now", acceptorSawit.GetName())
+			msg = c.T("sawit.duel.empty", acceptorSawit.GetName())
 		}
 		c.SendMessage(&waE2E.Message{
 			ExtendedTextMessage: &waE2E.ExtendedTextMessage{
@@ -98,12 +100,12 @@ func SawitAcceptChallenge(c *messageutil.MessageContext) error {
 					StanzaID:    &sawitAttack.MessageId,
 					Participant: proto.String(c.Client.GetLID().String()),
 					QuotedMessage: &waE2E.Message{
-						Conversation: proto.String("This is placeholder message, if you are seeing this, maybe the replied message is too old."),
+						Conversation: proto.String(c.T("sawit.duel.placeholder")),
 					},
 				},
 			},
 		})
-		return nil
+		return true, nil
 	}
 
 	isChallengerWin := r.Float32() <= 0.5
@@ -114,8 +116,8 @@ func SawitAcceptChallenge(c *messageutil.MessageContext) error {
 	sawitAttack.IsAttackerWin.Bool = isChallengerWin
 	tx = db.Save(&sawitAttack)
 	if tx.Error != nil {
-		c.Logger.Errorf("Failed to save sawit attack info: %s", err)
-		return err
+		c.Logger.Errorf("Failed to save sawit attack info: %s", tx.Error)
+		return true, tx.Error
 	}
 
 	// Save participant sawit states
@@ -133,23 +135,23 @@ func SawitAcceptChallenge(c *messageutil.MessageContext) error {
 	err = challengerSawit.Save()
 	if err != nil {
 		c.Logger.Errorf("Failed to save challenger sawit info: %s", err)
-		return err
+		return true, err
 	}
 	err = acceptorSawit.Save()
 	if err != nil {
 		c.Logger.Errorf("Failed to save acceptor sawit info: %s", err)
-		return err
+		return true, err
 	}
 
 	challengerPosition, err := sawit.GetParticipantPosition(c.Group.ID, challengerSawit.ParticipantId)
 	if err != nil {
 		c.Logger.Errorf("Failed to get challenger sawit rank position: %s", err)
-		return err
+		return true, err
 	}
 	acceptorPosition, err := sawit.GetParticipantPosition(c.Group.ID, acceptorSawit.ParticipantId)
 	if err != nil {
 		c.Logger.Errorf("Failed to get acceptor sawit rank position: %s", err)
-		return err
+		return true, err
 	}
 
 	winnerName := challengerSawit.GetName()
@@ -169,8 +171,8 @@ func SawitAcceptChallenge(c *messageutil.MessageContext) error {
 		winnerPosition, loserPosition = loserPosition, winnerPosition
 	}
 
-	msg := fmt.Sprintf(
-		"The winner is *%s*! His sawit is now *%d cm* long. The loser's one is *%d cm*\n\n*%s*'s position in the top is *%d*.\n*%s*'s position in the top is *%d*.\n\nWin rate of the *winner — %.02f%%*\nWin rate of the *loser — %.02f%%*",
+	msg := c.T(
+		"sawit.duel.result",
 		winnerName, winnerHeight, loserHeight, winnerName, winnerPosition, loserName, loserPosition, winnerWinrate, loserWinrate,
 	)
 	waMsg := &waE2E.Message{Conversation: &msg}
@@ -181,5 +183,5 @@ func SawitAcceptChallenge(c *messageutil.MessageContext) error {
 		c.EditMessageWithID(sawitAttack.MessageId, waMsg)
 	}
 
-	return nil
+	return true, nil
 }
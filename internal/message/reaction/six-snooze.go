@@ -0,0 +1,67 @@
+package reaction
+
+import (
+	"kano/internal/database/models"
+	"kano/internal/utils/messageutil"
+	"kano/internal/utils/word"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// Keycaps 1️⃣ to 9️⃣ snooze for that many steps, any other emoji for one
+const snoozeStep = 10 * time.Minute
+
+// Reports whether the reaction set or cleared a reminder snooze
+func SixReminderSnooze(c *messageutil.MessageContext) (bool, error) {
+	db := db.WithContext(c.Context())
+	if c.Group != nil || !c.IsReactedToMe() {
+		return false, nil
+	}
+
+	jid := c.GetChat()
+	reactedId := c.GetReactedMsgId()
+
+	if c.GetReaction() == "" {
+		// Reaction removed, so is the snooze
+		tx := db.Where("message_id = ? AND jid = ?", reactedId, jid).Delete(&models.ClassReminderSnooze{})
+		return tx.RowsAffected > 0, tx.Error
+	}
+
+	var sent models.ClassReminderMessage
+	tx := db.Where("message_id = ? AND jid = ?", reactedId, jid).Limit(1).Find(&sent)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		c.Logger.Debugf("Reacted message is not a reminder, or it is too old")
+		return false, nil
+	}
+
+	dur := snoozeDuration(c.GetReaction())
+	snooze := models.ClassReminderSnooze{
+		MessageId: sent.MessageId,
+		Jid:       jid,
+		Content:   sent.Content,
+		DueAtUnix: time.Now().Add(dur).Unix(),
+	}
+	tx = db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "message_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"due_at_unix"}),
+		}).
+		Create(&snooze)
+	if tx.Error != nil {
+		return true, tx.Error
+	}
+
+	c.ReplyT("six.reminder.snoozed", int(dur.Minutes()))
+	return true, nil
+}
+
+func snoozeDuration(emoji string) time.Duration {
+	if n, ok := word.KeycapDigit(emoji); ok && n > 0 {
+		return time.Duration(n) * snoozeStep
+	}
+	return snoozeStep
+}
@@ -1,7 +1,6 @@
 package reaction
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"kano/internal/database"
@@ -46,7 +45,7 @@ func downloadMediaFromVoRequest(c *messageutil.MessageContext, req models.VoRequ
 	}
 	if len(url) > 0 && !isWebWhatsappNetURL {
 		return c.Client.GetClient().DangerousInternals().DownloadAndDecrypt(
-			context.Background(),
+			c.Context(),
 			url,
 			[]byte(word.FromBase64(req.MediaKey)),
 			mediaType,
@@ -55,7 +54,7 @@ func downloadMediaFromVoRequest(c *messageutil.MessageContext, req models.VoRequ
 		)
 	} else if len(req.DirectPath.String) > 0 {
 		return c.Client.GetClient().DownloadMediaWithPath(
-			context.Background(),
+			c.Context(),
 			req.DirectPath.String,
 			[]byte(word.FromBase64(req.FileEncSha256)),
 			[]byte(word.FromBase64(req.FileSha256)),
@@ -69,7 +68,8 @@ func downloadMediaFromVoRequest(c *messageutil.MessageContext, req models.VoRequ
 	}
 }
 
-func VoReactApproval(c *messageutil.MessageContext) error {
+// Reports whether the reaction answered a pending vo request
+func VoReactApproval(c *messageutil.MessageContext) (bool, error) {
 	c.Logger.Debugf("Entered VoReactApproval function")
 
 	reactContent := c.GetReaction()
@@ -83,7 +83,7 @@ func VoReactApproval(c *messageutil.MessageContext) error {
 	reactedMsgJid, _ := types.ParseJID(part)
 	if !c.IsMe(reactedMsgJid) {
 		c.Logger.Infof("Reacted message is not to me")
-		return nil
+		return false, nil
 	}
 
 	reactedId := reactKey.GetID()
@@ -93,32 +93,32 @@ func VoReactApproval(c *messageutil.MessageContext) error {
 
 	if !isApproved && !isDisapproved {
 		c.Logger.Infof("No supported emojis")
-		return nil
+		return false, nil
 	}
 
-	db := database.GetInstance()
+	db := database.GetInstance().WithContext(c.Context())
 	req := models.VoRequest{ChatJid: c.GetChat(), ApprovalMessageId: reactedId}
 	tx := db.Where(&req).First(&req)
 	if tx.Error != nil {
 		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
 			c.Logger.Infof("No record found")
-			return nil
+			return false, nil
 		}
 
 		c.Logger.Errorf("%s", tx.Error)
-		return tx.Error
+		return false, tx.Error
 	}
 
 	if !c.IsSenderSame(req.MessageOwnerJid) {
 		c.Logger.Debugf("The reaction sender is not same as the vo message owner")
-		return nil
+		return false, nil
 	}
 
 	ctxInfo := &waE2E.ContextInfo{
 		StanzaID:    proto.String(reactKey.GetID()),
 		Participant: proto.String(part),
 		QuotedMessage: &waE2E.Message{
-			Conversation: proto.String("Reply placeholder. If you are seeing this, maybe your app is broken for some reason."),
+			Conversation: proto.String(c.T("vo.placeholder")),
 		},
 	}
 
@@ -126,13 +126,13 @@ func VoReactApproval(c *messageutil.MessageContext) error {
 		mediaBytes, err := downloadMediaFromVoRequest(c, req)
 		if err != nil {
 			c.Logger.Errorf("Failed to download: %s", tx.Error)
-			return err
+			return true, err
 		}
 
 		resp, err := c.Client.Upload(mediaBytes, whatsmeow.MediaImage)
 		if err != nil {
 			c.Logger.Errorf("Failed to upload: %s", tx.Error)
-			return err
+			return true, err
 		}
 
 		msg := &waE2E.Message{}
@@ -176,18 +176,18 @@ func VoReactApproval(c *messageutil.MessageContext) error {
 				ContextInfo:       ctxInfo,
 			}
 		} else {
-			return fmt.Errorf("unexpected media type %s", req.MediaType)
+			return true, fmt.Errorf("unexpected media type %s", req.MediaType)
 		}
 
 		c.SendMessage(msg)
 	} else {
 		c.SendMessage(&waE2E.Message{
 			ExtendedTextMessage: &waE2E.ExtendedTextMessage{
-				Text:        proto.String("Request denied."),
+				Text:        proto.String(c.T("vo.denied")),
 				ContextInfo: ctxInfo,
 			},
 		})
 	}
 
-	return nil
+	return true, nil
 }
@@ -0,0 +1,76 @@
+package message
+
+import (
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/utils/messageutil"
+	"kano/internal/utils/panicutil"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"google.golang.org/protobuf/proto"
+)
+
+const incidentReportInterval = time.Hour
+
+var (
+	reportedIncidents   = map[string]time.Time{}
+	reportedIncidentsMu sync.Mutex
+)
+
+// Runs fn and turns a panic into an error, so one bad message can't take the
+// whole bot down. name is the command (or "reaction") shown in the report.
+func dispatch(c *messageutil.MessageContext, name string, fn func(*messageutil.MessageContext) error) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		stack := debug.Stack()
+		hash := panicutil.Hash(r)
+		config.GetLogger().Sub("Panic").Errorf("Recovered from panic in %s at chat %s (%s): %v\n%s", name, c.GetChat(true), hash, r, stack)
+
+		c.React("❌")
+		reportIncident(c, name, hash, r)
+
+		err = fmt.Errorf("panic in %s: %v", name, r)
+	}()
+
+	return fn(c)
+}
+
+// Sends a short incident report to the owner, at most once per hour for the
+// same panic site
+func reportIncident(c *messageutil.MessageContext, name, hash string, r any) {
+	owner := config.GetConfig().OwnerJID
+	if owner.User == "" {
+		return
+	}
+
+	now := time.Now()
+	reportedIncidentsMu.Lock()
+	last, ok := reportedIncidents[hash]
+	if ok && now.Sub(last) < incidentReportInterval {
+		reportedIncidentsMu.Unlock()
+		return
+	}
+	reportedIncidents[hash] = now
+	for h, t := range reportedIncidents {
+		if now.Sub(t) >= incidentReportInterval {
+			delete(reportedIncidents, h)
+		}
+	}
+	reportedIncidentsMu.Unlock()
+
+	report := fmt.Sprintf(
+		"*Incident report*\nCommand: %s\nChat: %s\nStack hash: %s\nPanic: %v\n\nSee the logs for the full stack. Same incidents are muted for %s.",
+		name, c.GetChat(true), hash, r, incidentReportInterval,
+	)
+	_, err := c.Client.SendMessage(owner, &waE2E.Message{Conversation: proto.String(report)})
+	if err != nil {
+		c.Logger.Errorf("Failed to send incident report to owner: %s", err)
+	}
+}
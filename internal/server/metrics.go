@@ -0,0 +1,104 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"kano/internal/cronjobs"
+	"kano/internal/handler"
+	"kano/internal/logger"
+	"kano/internal/utils/usage"
+	"kano/internal/worker"
+	"maps"
+	"net/http"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.mau.fi/whatsmeow"
+)
+
+// Prometheus text exposition format, written by hand to avoid pulling in the
+// client library for a handful of gauges
+type metricWriter struct {
+	w    io.Writer
+	last string
+}
+
+func (m *metricWriter) write(name, kind, help string, value float64, labels ...string) {
+	if name != m.last {
+		fmt.Fprintf(m.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+		m.last = name
+	}
+
+	var pairs []string
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", labels[i], strconv.Quote(labels[i+1])))
+	}
+	if len(pairs) > 0 {
+		fmt.Fprintf(m.w, "%s{%s} %s\n", name, strings.Join(pairs, ","), strconv.FormatFloat(value, 'g', -1, 64))
+	} else {
+		fmt.Fprintf(m.w, "%s %s\n", name, strconv.FormatFloat(value, 'g', -1, 64))
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func metrics(cli *whatsmeow.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		m := &metricWriter{w: w}
+
+		st := handler.Status()
+		m.write("kano_connected", "gauge", "Whether the client is connected to WhatsApp.", boolValue(st.Connected && cli.IsConnected()))
+		m.write("kano_connects_total", "counter", "Connections made since startup.", float64(st.Connects))
+		m.write("kano_start_time_seconds", "gauge", "Unix time the bot started.", float64(startedAt.Unix()))
+
+		if pool := worker.Default(); pool != nil {
+			s := pool.Stats()
+			m.write("kano_worker_workers", "gauge", "Goroutines handling messages.", float64(s.Workers))
+			m.write("kano_worker_running", "gauge", "Messages being handled right now.", float64(s.Running))
+			m.write("kano_worker_queued", "gauge", "Messages waiting for a worker.", float64(s.Queued))
+			m.write("kano_worker_queue_capacity", "gauge", "Messages that may wait before new ones are rejected.", float64(s.MaxQueued))
+			m.write("kano_worker_rejected_total", "counter", "Messages rejected because the queue was full.", float64(s.Rejected))
+		}
+
+		counters := usage.Counters()
+		for _, c := range counters {
+			m.write("kano_commands_total", "counter", "Command dispatches by outcome.", float64(c.Count), "command", c.Command, "outcome", string(c.Outcome))
+		}
+		for _, c := range counters {
+			m.write("kano_command_duration_seconds_total", "counter", "Time spent in command dispatches by outcome.", c.Duration.Seconds(), "command", c.Command, "outcome", string(c.Outcome))
+		}
+
+		jobs := cronjobs.Status()
+		for _, j := range jobs {
+			m.write("kano_cron_runs_total", "counter", "Finished runs of a cron job.", float64(j.Runs), "job", j.Name)
+		}
+		for _, j := range jobs {
+			m.write("kano_cron_running", "gauge", "Whether a cron job is running right now.", boolValue(j.Running), "job", j.Name)
+		}
+		for _, j := range jobs {
+			m.write("kano_cron_last_duration_seconds", "gauge", "Duration of the last run of a cron job.", j.LastDuration.Seconds(), "job", j.Name)
+		}
+		for _, j := range jobs {
+			m.write("kano_cron_next_run_timestamp_seconds", "gauge", "Unix time of the next run of a cron job.", float64(j.Next.Unix()), "job", j.Name)
+		}
+
+		levels := logger.LevelCounts()
+		for _, level := range slices.Sorted(maps.Keys(levels)) {
+			m.write("kano_log_lines_total", "counter", "Log lines written by level.", float64(levels[level]), "level", level)
+		}
+
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+		m.write("kano_goroutines", "gauge", "Number of goroutines.", float64(runtime.NumGoroutine()))
+		m.write("kano_heap_alloc_bytes", "gauge", "Bytes of allocated heap objects.", float64(mem.HeapAlloc))
+		m.write("kano_sys_bytes", "gauge", "Bytes of memory obtained from the OS.", float64(mem.Sys))
+	}
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"kano/internal/config"
+	"kano/internal/cronjobs"
+	"kano/internal/handler"
+	"kano/internal/logger"
+	"kano/internal/message/handles"
+	"net/http"
+	"time"
+
+	"go.mau.fi/whatsmeow"
+)
+
+var log = config.GetLogger().Sub("Server")
+
+var startedAt = time.Now()
+
+type healthResponse struct {
+	Status           string    `json:"status"`
+	Connected        bool      `json:"connected"`
+	LoggedIn         bool      `json:"logged_in"`
+	Connects         int       `json:"connects"`
+	LastConnected    time.Time `json:"last_connected,omitzero"`
+	LastDisconnected time.Time `json:"last_disconnected,omitzero"`
+	Uptime           string    `json:"uptime"`
+}
+
+// Starts the status server in the background. Every endpoint except /health
+// needs the bearer token if one is given.
+func Start(addr, token string, cli *whatsmeow.Client) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", health(cli))
+	mux.Handle("GET /commands", withToken(token, http.HandlerFunc(commands)))
+	mux.Handle("GET /help", withToken(token, http.HandlerFunc(help)))
+	mux.Handle("GET /cron", withToken(token, http.HandlerFunc(cron)))
+	mux.Handle("GET /errors", withToken(token, http.HandlerFunc(recentErrors)))
+	mux.Handle("GET /metrics", withToken(token, metrics(cli)))
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		log.Infof("Listening on %s", addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("Status server stopped: %s", err)
+		}
+	}()
+
+	return srv
+}
+
+func withToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warnf("Failed to write response: %s", err)
+	}
+}
+
+// 200 while connected to WhatsApp, 503 otherwise, so uptime checkers can use
+// the status code alone
+func health(cli *whatsmeow.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		st := handler.Status()
+		res := healthResponse{
+			Status:           "ok",
+			Connected:        st.Connected && cli.IsConnected(),
+			LoggedIn:         cli.IsLoggedIn(),
+			Connects:         st.Connects,
+			LastConnected:    st.LastConnected,
+			LastDisconnected: st.LastDisconnected,
+			Uptime:           time.Since(startedAt).Round(time.Second).String(),
+		}
+
+		code := http.StatusOK
+		if !res.Connected {
+			res.Status = "disconnected"
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, res)
+	}
+}
+
+func commands(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, handles.Commands())
+}
+
+func help(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := handles.ExportHelpHTML(w); err != nil {
+		log.Warnf("Failed to render help page: %s", err)
+	}
+}
+
+func cron(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, cronjobs.Status())
+}
+
+func recentErrors(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, logger.Recent())
+}
@@ -0,0 +1,122 @@
+package argutil
+
+import (
+	"fmt"
+	"kano/internal/utils/parser"
+)
+
+type Type uint8
+
+const (
+	TypeString Type = iota
+	TypeInt
+	// Go duration (1h30m) with an extra d unit for days, or a bare number of minutes
+	TypeDuration
+	// WhatsApp mention, e.g. @123456789
+	TypeMention
+	// SIX class code with its number, e.g. ET1201-01
+	TypeClassCode
+	// Absolute http or https URL
+	TypeURL
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeString:
+		return "text"
+	case TypeInt:
+		return "number"
+	case TypeDuration:
+		return "duration"
+	case TypeMention:
+		return "mention"
+	case TypeClassCode:
+		return "class code"
+	case TypeURL:
+		return "url"
+	default:
+		return fmt.Sprintf("Type(%d)", t)
+	}
+}
+
+type Spec struct {
+	Name     string
+	Type     Type
+	Required bool
+	// Positional only, takes every remaining argument. Must be the last one.
+	Variadic bool
+}
+
+type Schema struct {
+	Positional []Spec
+	Named      []Spec
+}
+
+func (s Schema) IsZero() bool {
+	return len(s.Positional) == 0 && len(s.Named) == 0
+}
+
+type ArgError struct {
+	Spec   Spec
+	Value  string
+	Reason string
+}
+
+func (e ArgError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("argument %s: %s", e.Spec.Name, e.Reason)
+	}
+	return fmt.Sprintf("argument %s (%q): %s", e.Spec.Name, e.Value, e.Reason)
+}
+
+// Validates the parse result against the schema. Positional arguments are
+// counted from offset, so subcommands can skip their own name.
+func (s Schema) Validate(res parser.ParseResult, offset int) (Values, error) {
+	values := Values{values: map[string][]any{}}
+
+	args := []parser.Argument{}
+	if offset < len(res.Args) {
+		args = res.Args[offset:]
+	}
+
+	for i, spec := range s.Positional {
+		if i >= len(args) {
+			if spec.Required {
+				return values, ArgError{Spec: spec, Reason: "is required"}
+			}
+			continue
+		}
+
+		toParse := args[i : i+1]
+		if spec.Variadic {
+			toParse = args[i:]
+		}
+		for _, arg := range toParse {
+			val, err := parse(spec, arg.Content.Data)
+			if err != nil {
+				return values, err
+			}
+			values.values[spec.Name] = append(values.values[spec.Name], val)
+		}
+	}
+
+	for _, spec := range s.Named {
+		args, ok := res.NamedArgs[spec.Name]
+		if !ok || len(args) == 0 {
+			if spec.Required {
+				return values, ArgError{Spec: spec, Reason: "is required"}
+			}
+			continue
+		}
+
+		for _, arg := range args {
+			val, err := parse(spec, arg.Content.Data)
+			if err != nil {
+				return values, err
+			}
+			values.values[spec.Name] = append(values.values[spec.Name], val)
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,157 @@
+package argutil
+
+import (
+	"fmt"
+	"kano/internal/utils/word"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+type ClassCode struct {
+	Code   string
+	Number uint
+}
+
+func (c ClassCode) String() string {
+	return fmt.Sprintf("%s-%02d", c.Code, c.Number)
+}
+
+func parse(spec Spec, s string) (any, error) {
+	var val any
+	var err error
+
+	switch spec.Type {
+	case TypeString:
+		val = s
+	case TypeInt:
+		val, err = strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("not a valid number")
+		}
+	case TypeDuration:
+		val, err = ParseDuration(s)
+	case TypeMention:
+		val, err = ParseMention(s)
+	case TypeClassCode:
+		val, err = ParseClassCode(s)
+	case TypeURL:
+		val, err = ParseURL(s)
+	default:
+		err = fmt.Errorf("unknown argument type %s", spec.Type)
+	}
+
+	if err != nil {
+		return nil, ArgError{Spec: spec, Value: s, Reason: err.Error()}
+	}
+	return val, nil
+}
+
+// Parses 1d2h3m style durations. A bare number is taken as minutes.
+func ParseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(n) * time.Minute, nil
+	}
+
+	var days time.Duration
+	if d, rest, ok := strings.Cut(s, "d"); ok {
+		n, err := strconv.ParseInt(d, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid day count %q", d)
+		}
+		days = time.Duration(n) * 24 * time.Hour
+		if rest == "" {
+			return days, nil
+		}
+		s = rest
+	}
+
+	dur, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("not a valid duration, use something like 30m, 2h or 1d12h")
+	}
+	return days + dur, nil
+}
+
+// Mentions are written as @ followed by the user part of the JID. WhatsApp
+// puts the LID there, so the JID uses the hidden user server.
+func ParseMention(s string) (types.JID, error) {
+	user, ok := strings.CutPrefix(s, "@")
+	if !ok || user == "" {
+		return types.EmptyJID, fmt.Errorf("not a mention")
+	}
+	if _, err := strconv.ParseUint(user, 10, 64); err != nil {
+		return types.EmptyJID, fmt.Errorf("not a mention")
+	}
+	return types.NewJID(user, types.HiddenUserServer), nil
+}
+
+func ParseClassCode(s string) (ClassCode, error) {
+	code, numStr, ok := strings.Cut(s, "-")
+	if !ok {
+		return ClassCode{}, fmt.Errorf("missing dash between subject code and class number, e.g. ET1201-01")
+	}
+	code = strings.ToUpper(code)
+	if len(code) != 6 {
+		return ClassCode{}, fmt.Errorf("subject code length is not 6")
+	}
+	if !word.IsCharUpper(code[0]) || !word.IsCharUpper(code[1]) {
+		return ClassCode{}, fmt.Errorf("first two characters of subject code are not letters")
+	}
+	if _, err := strconv.ParseUint(code[2:], 10, 0); err != nil {
+		return ClassCode{}, fmt.Errorf("last four characters of subject code are not numbers")
+	}
+	num, err := strconv.ParseUint(numStr, 10, 0)
+	if err != nil {
+		return ClassCode{}, fmt.Errorf("class number %q is not a number", numStr)
+	}
+
+	return ClassCode{Code: code, Number: uint(num)}, nil
+}
+
+// One class code argument, Err is set when it is not a valid code
+type ParsedClassCode struct {
+	Raw   string
+	Class ClassCode
+	Err   error
+}
+
+// Parses the codes in the given order. A code naming an already given class
+// (e.g. et2202-1 after ET2202-01) is dropped, invalid codes are all kept so
+// each of them can be reported.
+func ParseClassCodes(codes []string) []ParsedClassCode {
+	res := make([]ParsedClassCode, 0, len(codes))
+	seen := []ClassCode{}
+	for _, code := range codes {
+		class, err := ParseClassCode(code)
+		if err == nil {
+			if slices.Contains(seen, class) {
+				continue
+			}
+			seen = append(seen, class)
+		}
+		res = append(res, ParsedClassCode{Raw: code, Class: class, Err: err})
+	}
+	return res
+}
+
+func ParseURL(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("not a parsable url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("url scheme must be http or https")
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("url has no host")
+	}
+	return u, nil
+}
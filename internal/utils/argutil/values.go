@@ -0,0 +1,33 @@
+package argutil
+
+// Arguments that passed the schema validation, keyed by Spec.Name
+type Values struct {
+	values map[string][]any
+}
+
+func (v Values) Has(name string) bool {
+	return len(v.values[name]) > 0
+}
+
+// Returns the first value of the argument. The type must match the Spec
+// type: string, int64, time.Duration, types.JID, ClassCode or *url.URL.
+func Get[T any](v Values, name string) (T, bool) {
+	var zero T
+	vals := v.values[name]
+	if len(vals) == 0 {
+		return zero, false
+	}
+	val, ok := vals[0].(T)
+	return val, ok
+}
+
+// Returns every value of a variadic or repeated named argument
+func GetAll[T any](v Values, name string) []T {
+	res := make([]T, 0, len(v.values[name]))
+	for _, val := range v.values[name] {
+		if t, ok := val.(T); ok {
+			res = append(res, t)
+		}
+	}
+	return res
+}
@@ -0,0 +1,143 @@
+package blocklist
+
+import (
+	"context"
+	"database/sql"
+	"kano/internal/database"
+	"kano/internal/database/models"
+	"slices"
+	"sync"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+	"gorm.io/gorm/clause"
+)
+
+// Every message goes through IsBlocked, so the table is kept in memory and
+// only read again after a change
+var (
+	entries map[types.JID]models.Blocklist
+	mu      sync.RWMutex
+)
+
+func load(ctx context.Context) error {
+	var rows []models.Blocklist
+	if tx := database.GetInstance().WithContext(ctx).Find(&rows); tx.Error != nil {
+		return tx.Error
+	}
+	store(rows)
+	return nil
+}
+
+func store(rows []models.Blocklist) {
+	loaded := index(rows)
+	mu.Lock()
+	entries = loaded
+	mu.Unlock()
+}
+
+func index(rows []models.Blocklist) map[types.JID]models.Blocklist {
+	res := make(map[types.JID]models.Blocklist, len(rows))
+	for _, row := range rows {
+		res[row.JID.ToNonAD()] = row
+	}
+	return res
+}
+
+func active(e models.Blocklist, now time.Time) bool {
+	return !e.ExpiresAt.Valid || e.ExpiresAt.Time.After(now)
+}
+
+func match(entries map[types.JID]models.Blocklist, now time.Time, jids ...types.JID) (models.Blocklist, bool) {
+	for _, jid := range jids {
+		if jid.IsEmpty() {
+			continue
+		}
+		if e, ok := entries[jid.ToNonAD()]; ok && active(e, now) {
+			return e, true
+		}
+	}
+	return models.Blocklist{}, false
+}
+
+// Splits rows into the active ones, newest first, and the expired ones
+func split(rows []models.Blocklist, now time.Time) (activeRows, expired []models.Blocklist) {
+	for _, row := range rows {
+		if active(row, now) {
+			activeRows = append(activeRows, row)
+		} else {
+			expired = append(expired, row)
+		}
+	}
+	slices.SortFunc(activeRows, func(a, b models.Blocklist) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return activeRows, expired
+}
+
+// Returns the first active entry matching one of the JIDs. Device and agent
+// parts are ignored. The table is read again on the next call if loading it
+// fails, the caller decides whether to let the message through.
+func IsBlocked(ctx context.Context, jids ...types.JID) (models.Blocklist, bool, error) {
+	mu.RLock()
+	isLoaded := entries != nil
+	mu.RUnlock()
+	if !isLoaded {
+		if err := load(ctx); err != nil {
+			return models.Blocklist{}, false, err
+		}
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+	e, ok := match(entries, time.Now(), jids...)
+	return e, ok, nil
+}
+
+// Adds or replaces the entry of jid. A zero expiresAt blocks forever.
+func Block(ctx context.Context, jid types.JID, reason string, expiresAt time.Time) error {
+	row := models.Blocklist{
+		JID:       jid.ToNonAD(),
+		Reason:    reason,
+		ExpiresAt: sql.NullTime{Time: expiresAt, Valid: !expiresAt.IsZero()},
+	}
+	tx := database.GetInstance().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "jid"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "expires_at", "created_at"}),
+	}).Create(&row)
+	if tx.Error != nil {
+		return tx.Error
+	}
+	return load(ctx)
+}
+
+// Returns false if jid wasn't blocked
+func Unblock(ctx context.Context, jid types.JID) (bool, error) {
+	tx := database.GetInstance().WithContext(ctx).Where("jid = ?", jid.ToNonAD()).Delete(&models.Blocklist{})
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+	return tx.RowsAffected > 0, load(ctx)
+}
+
+// Active entries, newest first. Expired ones are removed on the way.
+func List(ctx context.Context) ([]models.Blocklist, error) {
+	db := database.GetInstance().WithContext(ctx)
+
+	var rows []models.Blocklist
+	if tx := db.Find(&rows); tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	res, expired := split(rows, time.Now())
+	if len(expired) > 0 {
+		ids := make([]uint, len(expired))
+		for i, e := range expired {
+			ids[i] = e.ID
+		}
+		if tx := db.Delete(&models.Blocklist{}, ids); tx.Error != nil {
+			return nil, tx.Error
+		}
+	}
+
+	store(res)
+	return res, nil
+}
@@ -0,0 +1,128 @@
+package blocklist
+
+import (
+	"database/sql"
+	"kano/internal/database/models"
+	"slices"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestMatch(t *testing.T) {
+	now := time.Unix(1000, 0)
+	user := types.NewJID("6281234567890", types.DefaultUserServer)
+	lid := types.NewJID("123456789", types.HiddenUserServer)
+	group := types.NewJID("120363000000000000", types.GroupServer)
+
+	rows := []models.Blocklist{
+		{ID: 1, JID: user},
+		{ID: 2, JID: group, ExpiresAt: sql.NullTime{Time: now.Add(time.Minute), Valid: true}},
+		{ID: 3, JID: lid, ExpiresAt: sql.NullTime{Time: now, Valid: true}},
+	}
+	entries := index(rows)
+
+	device := user
+	device.Device = 12
+	lidDevice := lid
+	lidDevice.Device = 3
+
+	tests := []struct {
+		Name    string
+		Jids    []types.JID
+		Now     time.Time
+		Blocked bool
+		Id      uint
+	}{
+		{"forever", []types.JID{user}, now, true, 1},
+		{"device_part_ignored", []types.JID{device}, now, true, 1},
+		{"empty_skipped", []types.JID{{}, user}, now, true, 1},
+		{"group_chat", []types.JID{types.NewJID("1", types.DefaultUserServer), {}, group}, now, true, 2},
+		{"not_expired_yet", []types.JID{group}, now.Add(time.Minute - time.Second), true, 2},
+		{"expired", []types.JID{group}, now.Add(time.Minute), false, 0},
+		{"expires_now", []types.JID{lidDevice}, now, false, 0},
+		{"unknown", []types.JID{types.NewJID("1", types.DefaultUserServer)}, now, false, 0},
+		{"nothing", nil, now, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			e, blocked := match(entries, tt.Now, tt.Jids...)
+			if blocked != tt.Blocked {
+				t.Fatalf("expected blocked %t, got %t", tt.Blocked, blocked)
+			}
+			if e.ID != tt.Id {
+				t.Errorf("expected entry %d, got %d", tt.Id, e.ID)
+			}
+		})
+	}
+}
+
+func TestIndexNormalizes(t *testing.T) {
+	jid := types.NewJID("6281234567890", types.DefaultUserServer)
+	stored := jid
+	stored.Device = 5
+
+	entries := index([]models.Blocklist{{ID: 1, JID: stored}})
+	if _, ok := entries[jid]; !ok {
+		t.Errorf("expected %s to be indexed without the device part", stored)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	now := time.Unix(1000, 0)
+	at := func(sec int64) sql.NullTime { return sql.NullTime{Time: time.Unix(sec, 0), Valid: true} }
+
+	tests := []struct {
+		Name    string
+		Rows    []models.Blocklist
+		Active  []uint
+		Expired []uint
+	}{
+		{"empty", nil, nil, nil},
+		{
+			"newest_first",
+			[]models.Blocklist{
+				{ID: 1, CreatedAt: time.Unix(10, 0)},
+				{ID: 2, CreatedAt: time.Unix(30, 0), ExpiresAt: at(2000)},
+				{ID: 3, CreatedAt: time.Unix(20, 0)},
+			},
+			[]uint{2, 3, 1}, nil,
+		},
+		{
+			"expired_removed",
+			[]models.Blocklist{
+				{ID: 1, CreatedAt: time.Unix(10, 0), ExpiresAt: at(999)},
+				{ID: 2, CreatedAt: time.Unix(20, 0)},
+				{ID: 3, CreatedAt: time.Unix(30, 0), ExpiresAt: at(1000)},
+			},
+			[]uint{2}, []uint{1, 3},
+		},
+		{
+			"all_expired",
+			[]models.Blocklist{{ID: 1, ExpiresAt: at(1)}},
+			nil, []uint{1},
+		},
+	}
+
+	ids := func(rows []models.Blocklist) []uint {
+		var res []uint
+		for _, row := range rows {
+			res = append(res, row.ID)
+		}
+		return res
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			activeRows, expired := split(tt.Rows, now)
+			if got := ids(activeRows); !slices.Equal(got, tt.Active) {
+				t.Errorf("expected active %v, got %v", tt.Active, got)
+			}
+			if got := ids(expired); !slices.Equal(got, tt.Expired) {
+				t.Errorf("expected expired %v, got %v", tt.Expired, got)
+			}
+		})
+	}
+}
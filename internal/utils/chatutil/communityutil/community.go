@@ -7,6 +7,7 @@ import (
 	"kano/internal/config"
 	"kano/internal/database"
 	"kano/internal/database/models"
+	"sync"
 
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/types"
@@ -14,10 +15,11 @@ import (
 )
 
 var caches = map[string]*models.Community{}
+var cachesMu sync.RWMutex
 var log = config.GetLogger().Sub("CommunityUtil")
 
-func Init(cli *whatsmeow.Client, commJid types.JID) (*models.Community, error) {
-	if c, o := caches[commJid.String()]; o && c != nil {
+func Init(ctx context.Context, cli *whatsmeow.Client, commJid types.JID) (*models.Community, error) {
+	if c, o := getCache(commJid.String()); o && c != nil {
 		log.Debugf("Found community data at cache with name %s", c.Name)
 		return c, nil
 	}
@@ -27,7 +29,7 @@ func Init(cli *whatsmeow.Client, commJid types.JID) (*models.Community, error) {
 		return nil, fmt.Errorf("given jid server is not a group")
 	}
 
-	db := database.GetInstance()
+	db := database.GetInstance().WithContext(ctx)
 	comm := models.Community{JID: commJid}
 	tx := db.Where(&comm).First(&comm)
 
@@ -41,7 +43,7 @@ func Init(cli *whatsmeow.Client, commJid types.JID) (*models.Community, error) {
 		return nil, tx.Error
 	}
 
-	commInfo, err := cli.GetGroupInfo(context.Background(), commJid)
+	commInfo, err := cli.GetGroupInfo(ctx, commJid)
 	if err != nil {
 		log.Errorf("Failed to get community info: %s", err.Error())
 		return nil, nil
@@ -58,7 +60,7 @@ func Init(cli *whatsmeow.Client, commJid types.JID) (*models.Community, error) {
 		return nil, tx.Error
 	}
 
-	caches[commJid.String()] = &comm
+	setCache(commJid.String(), &comm)
 	return &comm, nil
 }
 
@@ -71,7 +73,7 @@ func Insert(commInfo types.GroupInfo) (*models.Community, error) {
 	}
 
 	commJid := commInfo.JID
-	if c, o := caches[commJid.String()]; o && c != nil {
+	if c, o := getCache(commJid.String()); o && c != nil {
 		log.Debugf("Found community data at cache with name %s", c.Name)
 		return c, nil
 	}
@@ -103,12 +105,12 @@ func Insert(commInfo types.GroupInfo) (*models.Community, error) {
 		return nil, tx.Error
 	}
 
-	caches[commJid.String()] = &comm
+	setCache(commJid.String(), &comm)
 	return &comm, nil
 }
 
 func Get(commJid types.JID) (*models.Community, error) {
-	if c, o := caches[commJid.String()]; o && c != nil {
+	if c, o := getCache(commJid.String()); o && c != nil {
 		log.Debugf("Found community data at cache with name %s", c.Name)
 		return c, nil
 	}
@@ -122,3 +124,16 @@ func Get(commJid types.JID) (*models.Community, error) {
 		return &comm, nil
 	}
 }
+
+func getCache(key string) (*models.Community, bool) {
+	cachesMu.RLock()
+	defer cachesMu.RUnlock()
+	c, ok := caches[key]
+	return c, ok
+}
+
+func setCache(key string, val *models.Community) {
+	cachesMu.Lock()
+	defer cachesMu.Unlock()
+	caches[key] = val
+}
@@ -1,6 +1,7 @@
 package contactutil
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -12,19 +13,22 @@ import (
 	"gorm.io/gorm"
 )
 
-var log = config.GetLogger().Sub("ContactUtil")
-
 type Contact struct {
 	ID            uint
 	JID           types.JID
 	Pushname      string
 	CustomName    string
 	ConfessTarget sql.NullInt32
+
+	Prefixes                []string
+	IsMentionTriggerAllowed bool
+
+	Language string
 }
 
-func Init(jid types.JID, pushname string) (*Contact, error) {
+func Init(ctx context.Context, jid types.JID, pushname string) (*Contact, error) {
 	contact := Contact{}
-	model, err := initDb(jid, pushname)
+	model, err := initDb(ctx, jid, pushname)
 	if err != nil {
 		return &contact, err
 	}
@@ -34,6 +38,9 @@ func Init(jid types.JID, pushname string) (*Contact, error) {
 	contact.Pushname = model.PushName
 	contact.CustomName = model.CustomName
 	contact.ConfessTarget = model.ConfessTarget
+	contact.Prefixes = model.Prefixes
+	contact.IsMentionTriggerAllowed = model.IsMentionTriggerAllowed
+	contact.Language = model.Language
 
 	return &contact, nil
 }
@@ -47,6 +54,11 @@ func (c *Contact) Save() error {
 		PushName:      c.Pushname,
 		CustomName:    c.CustomName,
 		ConfessTarget: c.ConfessTarget,
+
+		Prefixes:                c.Prefixes,
+		IsMentionTriggerAllowed: c.IsMentionTriggerAllowed,
+
+		Language: c.Language,
 	}
 
 	db := database.GetInstance()
@@ -56,6 +68,7 @@ func (c *Contact) Save() error {
 }
 
 func GetIDs(jids []types.JID) (map[types.JID]uint, error) {
+	log := config.GetLogger().Sub("ContactUtil")
 	if len(jids) == 0 {
 		return map[types.JID]uint{}, nil
 	}
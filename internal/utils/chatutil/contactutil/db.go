@@ -1,25 +1,46 @@
 package contactutil
 
 import (
+	"context"
 	"fmt"
 	"kano/internal/database"
 	"kano/internal/database/models"
+	"kano/internal/utils/i18n"
 
 	"go.mau.fi/whatsmeow/types"
 )
 
-var db = database.GetInstance()
-
-func initDb(jid types.JID, pushname string) (*models.Contact, error) {
+func initDb(ctx context.Context, jid types.JID, pushname string) (*models.Contact, error) {
 	if jid.Server != types.HiddenUserServer && jid.Server != types.DefaultUserServer {
 		return nil, fmt.Errorf("given jid server is not @lid")
 	}
 
 	contact := models.Contact{}
-	tx := db.
+	tx := database.GetInstance().WithContext(ctx).
 		Where(models.Contact{JID: jid}).
 		Assign(models.Contact{PushName: pushname}).
 		FirstOrCreate(&contact)
 
 	return &contact, tx.Error
 }
+
+// Languages picked by the given contacts, the ones without a preference (or
+// without a contact row) get the default language
+func Langs(ctx context.Context, jids []types.JID) (map[types.JID]i18n.Lang, error) {
+	var contacts []models.Contact
+	tx := database.GetInstance().WithContext(ctx).Select("jid", "language").Where("jid IN ? AND language <> ''", jids).Find(&contacts)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	langs := make(map[types.JID]i18n.Lang, len(jids))
+	for _, jid := range jids {
+		langs[jid] = i18n.Default
+	}
+	for _, contact := range contacts {
+		if lang, ok := i18n.Parse(contact.Language); ok {
+			langs[contact.JID] = lang
+		}
+	}
+	return langs, nil
+}
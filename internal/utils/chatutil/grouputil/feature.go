@@ -0,0 +1,66 @@
+package grouputil
+
+import (
+	"fmt"
+	"kano/internal/config"
+	"kano/internal/utils/i18n"
+	"slices"
+	"strings"
+)
+
+// A per group toggle. Groups without a stored value use DefaultEnabled.
+type Feature struct {
+	Key         string
+	Description i18n.MessageID
+	Default     bool
+}
+
+func (f Feature) IsZero() bool {
+	return f.Key == ""
+}
+
+// Default unless the config overrides it
+func (f Feature) DefaultEnabled() bool {
+	if enabled, ok := config.GetConfig().FeatureDefaults[f.Key]; ok {
+		return enabled
+	}
+	return f.Default
+}
+
+var features = map[string]Feature{}
+
+// Adds the feature to the registry so `.enable` can list and toggle it.
+// Meant to be called from package level vars, registering the same key
+// twice panics.
+func RegisterFeature(f Feature) Feature {
+	if f.IsZero() {
+		panic("feature key must not be empty")
+	}
+	if _, ok := features[f.Key]; ok {
+		panic(fmt.Sprintf("feature %q is already registered", f.Key))
+	}
+	features[f.Key] = f
+	return f
+}
+
+func LookupFeature(key string) (Feature, bool) {
+	f, ok := features[strings.ToLower(key)]
+	return f, ok
+}
+
+// Every registered feature, sorted by key
+func Features() []Feature {
+	res := make([]Feature, 0, len(features))
+	for _, f := range features {
+		res = append(res, f)
+	}
+	slices.SortFunc(res, func(a, b Feature) int { return strings.Compare(a.Key, b.Key) })
+	return res
+}
+
+// SQL condition that holds when the feature is enabled for the group whose
+// ID is in groupColumn, for queries spanning many groups
+func FeatureCondition(f Feature, groupColumn string) (string, []any) {
+	cond := fmt.Sprintf(`COALESCE((SELECT enabled FROM group_feature WHERE group_id = %s AND "key" = ?), ?)`, groupColumn)
+	return cond, []any{f.Key, f.DefaultEnabled()}
+}
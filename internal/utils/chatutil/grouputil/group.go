@@ -10,6 +10,7 @@ import (
 	"kano/internal/database/models"
 	"kano/internal/utils/chatutil/communityutil"
 	"kano/internal/utils/chatutil/contactutil"
+	"sync"
 
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/types"
@@ -17,6 +18,7 @@ import (
 )
 
 var caches = map[string]*models.Group{}
+var cachesMu sync.RWMutex
 var log = config.GetLogger().Sub("GroupUtil")
 
 type Group struct {
@@ -25,8 +27,8 @@ type Group struct {
 	GroupSettings *GroupSettings
 }
 
-func Init(cli *whatsmeow.Client, groupJid types.JID) (*Group, error) {
-	group, err := InitDb(cli, groupJid)
+func Init(ctx context.Context, cli *whatsmeow.Client, groupJid types.JID) (*Group, error) {
+	group, err := InitDb(ctx, cli, groupJid)
 	if group != nil {
 		settings, err := InitSettings(group.ID)
 		if err != nil {
@@ -37,8 +39,8 @@ func Init(cli *whatsmeow.Client, groupJid types.JID) (*Group, error) {
 	return &Group{}, err
 }
 
-func InitDb(cli *whatsmeow.Client, groupJid types.JID) (*models.Group, error) {
-	if c, o := caches[groupJid.String()]; o && c != nil {
+func InitDb(ctx context.Context, cli *whatsmeow.Client, groupJid types.JID) (*models.Group, error) {
+	if c, o := getCache(groupJid.String()); o && c != nil {
 		log.Debugf("Found group data at cache with name %s", c.Name)
 		return c, nil
 	}
@@ -48,7 +50,7 @@ func InitDb(cli *whatsmeow.Client, groupJid types.JID) (*models.Group, error) {
 		return nil, fmt.Errorf("given jid server is not a group")
 	}
 
-	db := database.GetInstance()
+	db := database.GetInstance().WithContext(ctx)
 	grp := models.Group{JID: groupJid}
 	tx := db.Where(&grp).First(&grp)
 
@@ -63,14 +65,14 @@ func InitDb(cli *whatsmeow.Client, groupJid types.JID) (*models.Group, error) {
 		return nil, tx.Error
 	}
 
-	grpInfo, err := cli.GetGroupInfo(context.Background(), groupJid)
+	grpInfo, err := cli.GetGroupInfo(ctx, groupJid)
 	if err != nil {
 		log.Errorf("Failed to get group info: %s", err.Error())
 		return nil, err
 	}
 
 	if grpInfo.LinkedParentJID.Server == types.GroupServer {
-		comm, err := communityutil.Init(cli, grpInfo.LinkedParentJID)
+		comm, err := communityutil.Init(ctx, cli, grpInfo.LinkedParentJID)
 		if err != nil {
 			log.Errorf("Failed to init community: %s", err.Error())
 		}
@@ -131,7 +133,7 @@ func InitDb(cli *whatsmeow.Client, groupJid types.JID) (*models.Group, error) {
 		return nil, tx.Error
 	}
 
-	caches[groupJid.String()] = &grp
+	setCache(groupJid.String(), &grp)
 	return &grp, nil
 }
 
@@ -141,7 +143,7 @@ func Insert(grpInfo types.GroupInfo) (*models.Group, error) {
 	}
 
 	groupJid := grpInfo.JID
-	if c, o := caches[groupJid.String()]; o && c != nil {
+	if c, o := getCache(groupJid.String()); o && c != nil {
 		log.Debugf("Found group data at cache with name %s", c.Name)
 		return c, nil
 	}
@@ -201,7 +203,7 @@ func Insert(grpInfo types.GroupInfo) (*models.Group, error) {
 		return nil, tx.Error
 	}
 
-	caches[groupJid.String()] = &grp
+	setCache(groupJid.String(), &grp)
 	return &grp, nil
 }
 
@@ -211,7 +213,7 @@ func Update(grpInfo types.GroupInfo) (*models.Group, error) {
 	}
 
 	groupJid := grpInfo.JID
-	if c, o := caches[groupJid.String()]; o && c != nil {
+	if c, o := getCache(groupJid.String()); o && c != nil {
 		log.Debugf("Found group data at cache with name %s", c.Name)
 		return c, nil
 	}
@@ -271,7 +273,7 @@ func Update(grpInfo types.GroupInfo) (*models.Group, error) {
 		return nil, tx.Error
 	}
 
-	caches[groupJid.String()] = &grp
+	setCache(groupJid.String(), &grp)
 	return &grp, nil
 }
 
@@ -290,3 +292,16 @@ func (g Group) GetParticipantRole(contactId uint) (models.ParticipantRole, error
 
 	return part.Role, nil
 }
+
+func getCache(key string) (*models.Group, bool) {
+	cachesMu.RLock()
+	defer cachesMu.RUnlock()
+	c, ok := caches[key]
+	return c, ok
+}
+
+func setCache(key string, val *models.Group) {
+	cachesMu.Lock()
+	defer cachesMu.Unlock()
+	caches[key] = val
+}
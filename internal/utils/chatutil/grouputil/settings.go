@@ -3,10 +3,15 @@ package grouputil
 import (
 	"kano/internal/database"
 	"kano/internal/database/models"
+
+	"gorm.io/gorm/clause"
 )
 
 type GroupSettings struct {
 	models.GroupSettings
+
+	// Stored feature values by key, see Feature
+	features map[string]bool
 }
 
 func InitSettings(groupId uint) (*GroupSettings, error) {
@@ -18,14 +23,53 @@ func InitSettings(groupId uint) (*GroupSettings, error) {
 		return nil, tx.Error
 	}
 
+	var rows []models.GroupFeature
+	tx = db.Where("group_id = ?", groupId).Find(&rows)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	settings.features = make(map[string]bool, len(rows))
+	for _, r := range rows {
+		settings.features[r.Key] = r.Enabled
+	}
+
 	return &settings, nil
 }
 
+func (gs *GroupSettings) IsEnabled(f Feature) bool {
+	if enabled, ok := gs.features[f.Key]; ok {
+		return enabled
+	}
+	return f.DefaultEnabled()
+}
+
+func (gs *GroupSettings) SetEnabled(f Feature, enabled bool) error {
+	row := models.GroupFeature{GroupId: gs.ID, Key: f.Key, Enabled: enabled}
+
+	db := database.GetInstance()
+	tx := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "group_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&row)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if gs.features == nil {
+		gs.features = map[string]bool{}
+	}
+	gs.features[f.Key] = enabled
+	return nil
+}
+
 func (gs *GroupSettings) Save() error {
 	settings := models.GroupSettings{
-		ID:               gs.ID,
-		IsGameAllowed:    gs.IsGameAllowed,
-		IsConfessAllowed: gs.IsConfessAllowed,
+		ID: gs.ID,
+
+		Prefixes:                gs.Prefixes,
+		IsMentionTriggerAllowed: gs.IsMentionTriggerAllowed,
+
+		Language: gs.Language,
 	}
 
 	db := database.GetInstance()
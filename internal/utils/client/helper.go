@@ -1,7 +1,6 @@
 package client
 
 import (
-	"context"
 	"io"
 	"kano/internal/config"
 
@@ -14,33 +13,37 @@ var log = config.GetLogger().Sub("Client")
 
 func (c *ClientContext) SendMessage(to types.JID, message *waE2E.Message, extra ...whatsmeow.SendRequestExtra) (whatsmeow.SendResponse, error) {
 	log.Debugf("Sending message to %s", to.String())
-	return c.client.SendMessage(context.Background(), to, message, extra...)
+	return c.client.SendMessage(c.ctx, to, message, extra...)
 }
 
 func (c *ClientContext) Download(msg whatsmeow.DownloadableMessage) ([]byte, error) {
-	return c.client.Download(context.Background(), msg)
+	return c.client.Download(c.ctx, msg)
 }
 
 func (c *ClientContext) Upload(content []byte, mediaType whatsmeow.MediaType) (whatsmeow.UploadResponse, error) {
-	return c.client.Upload(context.Background(), content, mediaType)
+	return c.client.Upload(c.ctx, content, mediaType)
 }
 
 func (c *ClientContext) UploadReader(plaintext io.Reader, appInfo whatsmeow.MediaType) (whatsmeow.UploadResponse, error) {
-	return c.client.UploadReader(context.Background(), plaintext, nil, appInfo)
+	return c.client.UploadReader(c.ctx, plaintext, nil, appInfo)
 }
 
 func (c *ClientContext) GetGroupInfo(jid types.JID) (*types.GroupInfo, error) {
-	return c.client.GetGroupInfo(context.Background(), jid)
+	return c.client.GetGroupInfo(c.ctx, jid)
 }
 
 func (c *ClientContext) GetSubGroups(community types.JID) ([]*types.GroupLinkTarget, error) {
-	return c.client.GetSubGroups(context.Background(), community)
+	return c.client.GetSubGroups(c.ctx, community)
 }
 
 func (c *ClientContext) GetJoinedGroups() ([]*types.GroupInfo, error) {
-	return c.client.GetJoinedGroups(context.Background())
+	return c.client.GetJoinedGroups(c.ctx)
 }
 
 func (c *ClientContext) BuildEdit(chat types.JID, id types.MessageID, newContent *waE2E.Message) *waE2E.Message {
 	return c.client.BuildEdit(chat, id, newContent)
 }
+
+func (c *ClientContext) LeaveGroup(jid types.JID) error {
+	return c.client.LeaveGroup(c.ctx, jid)
+}
@@ -1,21 +1,19 @@
 package client
 
 import (
-	"context"
-
 	"go.mau.fi/whatsmeow/types"
 )
 
 func (c *ClientContext) GetLIDForPN(pn types.JID) (types.JID, error) {
-	return c.Store.LIDs.GetLIDForPN(context.Background(), pn)
+	return c.Store.LIDs.GetLIDForPN(c.ctx, pn)
 }
 
 func (c *ClientContext) GetPNForLID(lid types.JID) (types.JID, error) {
-	return c.Store.LIDs.GetPNForLID(context.Background(), lid)
+	return c.Store.LIDs.GetPNForLID(c.ctx, lid)
 }
 
 func (c *ClientContext) GetManyLIDsForPNs(pns []types.JID) (map[types.JID]types.JID, error) {
-	return c.Store.LIDs.GetManyLIDsForPNs(context.Background(), pns)
+	return c.Store.LIDs.GetManyLIDsForPNs(c.ctx, pns)
 }
 
 func (c *ClientContext) GetJID() types.JID {
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/store"
 )
@@ -8,15 +10,23 @@ import (
 type ClientContext struct {
 	client *whatsmeow.Client
 	Store  *store.Device
+
+	// Passed to every call made through the helpers
+	ctx context.Context
 }
 
-func CreateContext(cli *whatsmeow.Client) *ClientContext {
+func CreateContext(ctx context.Context, cli *whatsmeow.Client) *ClientContext {
 	return &ClientContext{
 		client: cli,
 		Store:  cli.Store,
+		ctx:    ctx,
 	}
 }
 
+func (c *ClientContext) Context() context.Context {
+	return c.ctx
+}
+
 func (c *ClientContext) GetClient() *whatsmeow.Client {
 	return c.client
 }
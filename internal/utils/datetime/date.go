@@ -0,0 +1,13 @@
+package datetime
+
+import "time"
+
+// Midnight of a YYYY-MM-DD, DD-MM-YYYY or DD/MM/YYYY date in loc
+func ParseDate(s string, loc *time.Location) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", "02-01-2006", "02/01/2006"} {
+		if date, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
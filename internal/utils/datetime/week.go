@@ -4,21 +4,21 @@ import (
 	"time"
 )
 
-var jakarta *time.Location
-
-func init() {
-	var err error
-	jakarta, err = time.LoadLocation("Asia/Jakarta")
-	if err != nil {
-		panic(err)
-	}
-}
-
-func StartOfWeek(t time.Time) time.Time {
-	t = t.In(jakarta)
-	newT := time.Date(
+// Midnight of the Monday of t's week in loc
+func StartOfWeek(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(
 		t.Year(), t.Month(), t.Day()-((int(t.Weekday())+6)%7),
-		0, 0, 0, 0, jakarta,
+		0, 0, 0, 0, loc,
 	)
-	return newT
+}
+
+// Midnight of the first Monday after t in t's location, a Monday gets the
+// one a week later
+func NextMonday(t time.Time) time.Time {
+	days := (8 - int(t.Weekday())) % 7
+	if days == 0 {
+		days = 7
+	}
+	return time.Date(t.Year(), t.Month(), t.Day()+days, 0, 0, 0, 0, t.Location())
 }
@@ -1,12 +1,26 @@
 package definition
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"kano/internal/utils/httpclient"
 	"net/http"
 	"net/url"
+	"time"
 )
 
+// Definitions rarely change, keep them for a week
+var client = httpclient.New(httpclient.Options{
+	Timeout:      15 * time.Second,
+	MaxRetries:   2,
+	BaseDelay:    time.Second,
+	MaxDelay:     10 * time.Second,
+	MaxBodyBytes: 2 << 20,
+	PerHost:      4,
+	CacheTTL:     7 * 24 * time.Hour,
+})
+
 type DictAPITextObject struct {
 	Text string `json:"text,omitempty"`
 }
@@ -72,11 +86,15 @@ type DictAPIResponse struct {
 	LastUpdated uint            `json:"last_updated,omitempty"`
 }
 
-func FindDefinition(word string) (*DictAPIResponse, error) {
+func FindDefinition(ctx context.Context, word string) (*DictAPIResponse, error) {
 	word = url.PathEscape(word)
 	apiUrl := fmt.Sprintf("https://dict-api.com/api/od/%s", word)
 
-	resp, err := http.Get(apiUrl)
+	req, err := http.NewRequestWithContext(ctx, "GET", apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
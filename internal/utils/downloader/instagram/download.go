@@ -1,6 +1,7 @@
 package instagram
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,8 +22,6 @@ type instagramRuling struct {
 }
 
 func Download(ctx *types.DownloaderContext, igUrl *url.URL) error {
-	cli := http.Client{}
-
 	id := ""
 	paths := strings.Split(igUrl.Path, "/")
 	if len(paths) > 2 {
@@ -41,7 +40,7 @@ func Download(ctx *types.DownloaderContext, igUrl *url.URL) error {
 		return fmt.Errorf("unable to get content id from url, is the format changed?")
 	}
 
-	_, csrftoken := instagramApiCheck(id)
+	_, csrftoken := instagramApiCheck(ctx.Context(), id)
 
 	variables := map[string]string{"shortcode": id}
 	variablesMar, _ := json.Marshal(variables)
@@ -52,14 +51,14 @@ func Download(ctx *types.DownloaderContext, igUrl *url.URL) error {
 	q.Add("variables", string(variablesMar))
 	infoUrl.RawQuery = q.Encode()
 
-	infoReq, _ := instagramCreateReq(infoUrl.String())
+	infoReq, _ := instagramCreateReq(ctx.Context(), infoUrl.String())
 	infoReq.Header.Set("X-Requested-With", "XMLHttpRequest")
 	infoReq.Header.Set("Referer", igUrl.String())
 	if csrftoken != "" {
 		infoReq.Header.Set("X-CSRF-Token", csrftoken)
 	}
 
-	infoResp, err := cli.Do(infoReq)
+	infoResp, err := types.MediaClient().Do(infoReq)
 	if err != nil {
 		return fmt.Errorf("failed to get general info: %s", err)
 	}
@@ -88,18 +87,18 @@ func Download(ctx *types.DownloaderContext, igUrl *url.URL) error {
 	return nil
 }
 
-func downloadMedia(url string, useTempFile bool) (io.ReadCloser, error) {
-	cli := http.Client{}
-	req, err := instagramCreateReq(url)
+func downloadMedia(ctx context.Context, url string, useTempFile bool) (io.ReadCloser, error) {
+	req, err := instagramCreateReq(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create media request: %s", err)
 	}
-	resp, err := cli.Do(req)
+	resp, err := types.MediaClient().Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to do media request: %s", err)
 	}
 
 	if resp.StatusCode > 299 {
+		resp.Body.Close()
 		return nil, fmt.Errorf("server responded with status %s", resp.Status)
 	}
 
@@ -109,10 +108,12 @@ func downloadMedia(url string, useTempFile bool) (io.ReadCloser, error) {
 			return nil, fmt.Errorf("failed to create temp file: %s", err)
 		}
 		_, err = io.Copy(tmpFile, resp.Body)
+		resp.Body.Close()
 		if err != nil {
+			tmpFile.Close()
+			os.Remove(tmpFile.Name())
 			return nil, fmt.Errorf("failed to copy buffer into the temp file: %s", err)
 		}
-		resp.Body.Close()
 
 		return tmpFile, nil
 	} else {
@@ -174,13 +175,13 @@ func mergeVideoAudio(vidReader, audReader io.ReadCloser, vidId, audId string) (i
 
 func processMedia(ctx *types.DownloaderContext, media igParsed_Media) error {
 	if media.IsVideo {
-		vidBytes, err := downloadMedia(media.Url, false)
+		vidBytes, err := downloadMedia(ctx.Context(), media.Url, false)
 		if err != nil {
 			return err
 		}
 
 		if media.AudioUrl != "" {
-			audBytes, err := downloadMedia(media.AudioUrl, false)
+			audBytes, err := downloadMedia(ctx.Context(), media.AudioUrl, false)
 			if err != nil {
 				return err
 			}
@@ -191,7 +192,7 @@ func processMedia(ctx *types.DownloaderContext, media igParsed_Media) error {
 			ctx.AddMedia(vidBytes, true, "video/mp4", media.Dimensions.Height, media.Dimensions.Width, media.Duration)
 		}
 	} else {
-		imgReader, err := downloadMedia(media.Url, true)
+		imgReader, err := downloadMedia(ctx.Context(), media.Url, true)
 		if err != nil {
 			return err
 		}
@@ -1,35 +1,31 @@
 package instagram
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"kano/internal/config"
+	"kano/internal/utils/downloader/types"
 	"net/http"
+	"strings"
 )
 
 const instagram_encoding = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
 
-var instagram_table = map[uint8]int{}
-
 func instagramIdToPk(id string) uint64 {
-	if len(instagram_table) == 0 {
-		for i := range len(instagram_encoding) {
-			instagram_table[instagram_encoding[i]] = i
-		}
-	}
-
-	result, base := uint64(0), uint64(len(instagram_table))
+	result, base := uint64(0), uint64(len(instagram_encoding))
 	for i := range len(id) {
-		result = (result * base) + uint64(instagram_table[id[i]])
+		// Characters outside the encoding count as 0
+		result = (result * base) + uint64(max(strings.IndexByte(instagram_encoding, id[i]), 0))
 	}
 
 	return result
 }
 
-func instagramApiCheck(id string) (apiCheck instagramRuling, csrftoken string) {
+func instagramApiCheck(ctx context.Context, id string) (apiCheck instagramRuling, csrftoken string) {
 	// Create request
-	cli := http.Client{}
 	checkReq, err := instagramCreateReq(
+		ctx,
 		fmt.Sprintf(
 			"https://i.instagram.com/api/v1/web/get_ruling_for_content/?content_type=MEDIA&target_id=%d",
 			instagramIdToPk(id),
@@ -41,7 +37,7 @@ func instagramApiCheck(id string) (apiCheck instagramRuling, csrftoken string) {
 
 	// Do the request and parse it
 	// If it is returned errors, just return empty struct
-	checkResp, err := cli.Do(checkReq)
+	checkResp, err := types.MediaClient().Do(checkReq)
 	if err == nil {
 		// Parse the response
 		json.NewDecoder(checkResp.Body).Decode(&apiCheck)
@@ -63,8 +59,8 @@ func instagramApiCheck(id string) (apiCheck instagramRuling, csrftoken string) {
 	return
 }
 
-func instagramCreateReq(url string) (*http.Request, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func instagramCreateReq(ctx context.Context, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return req, err
 	}
@@ -74,7 +70,7 @@ func instagramCreateReq(url string) (*http.Request, error) {
 	req.Header.Set("X-IG-WWW-Claim", "0")
 	req.Header.Set("Origin", "https://www.instagram.com")
 	req.Header.Set("Accept", "*/*")
-	req.Header.Set("User-Agent", config.USER_AGENT)
+	req.Header.Set("User-Agent", config.GetConfig().UserAgent)
 
 	return req, nil
 }
@@ -1,6 +1,7 @@
 package downloader
 
 import (
+	"context"
 	"fmt"
 	"kano/internal/utils/downloader/instagram"
 	"kano/internal/utils/downloader/tiktok"
@@ -10,8 +11,8 @@ import (
 	"net/url"
 )
 
-func Download(urlStr string) (types.DownloaderContext, error) {
-	ctx := types.DownloaderContext{}
+func Download(reqCtx context.Context, urlStr string) (types.DownloaderContext, error) {
+	ctx := types.NewContext(reqCtx)
 	u, err := url.Parse(urlStr)
 	if err != nil {
 		return ctx, fmt.Errorf("url is not parsable")
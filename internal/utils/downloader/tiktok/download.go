@@ -8,7 +8,7 @@ import (
 )
 
 func Download(ctx *types.DownloaderContext, url *url.URL) error {
-	res, err := ytdlpbind.Call(url.String())
+	res, err := ytdlpbind.Call(ctx.Context(), url.String())
 	if err != nil {
 		return err
 	}
@@ -19,7 +19,7 @@ func Download(ctx *types.DownloaderContext, url *url.URL) error {
 			return fmt.Errorf("file size is too big (>200 MB)")
 		}
 
-		resp, err := ytdlpbind.Request(res.YtDlpFormat)
+		resp, err := ytdlpbind.Request(ctx.Context(), res.YtDlpFormat)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"kano/internal/config"
+	"kano/internal/utils/httpclient"
+	"sync/atomic"
+	"time"
+)
+
+var mediaClient atomic.Pointer[httpclient.Client]
+
+// Client for the downloaders. Media bodies stay open until they are uploaded,
+// so there is no per host limit here and the timeout is left to the context.
+// Rebuilt when the download limit is changed by a config reload.
+func MediaClient() *httpclient.Client {
+	limit := config.GetConfig().DownloadMaxBytes
+	if c := mediaClient.Load(); c != nil && c.Options().MaxBodyBytes == limit {
+		return c
+	}
+
+	c := httpclient.New(httpclient.Options{
+		MaxRetries:   2,
+		BaseDelay:    time.Second,
+		MaxDelay:     10 * time.Second,
+		MaxBodyBytes: limit,
+	})
+	mediaClient.Store(c)
+	return c
+}
@@ -1,12 +1,26 @@
 package types
 
-import "io"
+import (
+	"context"
+	"io"
+)
 
 type DownloaderContext struct {
+	ctx     context.Context
 	caption string
 	medias  []DownloaderMedia
 }
 
+func NewContext(ctx context.Context) DownloaderContext {
+	return DownloaderContext{ctx: ctx}
+}
+
+// Requests made by the downloaders, including reading the media later on,
+// stop when this is done
+func (d DownloaderContext) Context() context.Context {
+	return d.ctx
+}
+
 type DownloaderMedia struct {
 	reader      io.Reader
 	isVideo     bool
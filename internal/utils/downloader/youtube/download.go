@@ -12,7 +12,7 @@ import (
 )
 
 func Download(ctx *types.DownloaderContext, url *url.URL) error {
-	res, err := ytdlpbind.Call(url.String())
+	res, err := ytdlpbind.Call(ctx.Context(), url.String())
 	if err != nil {
 		return err
 	}
@@ -47,7 +47,7 @@ func Download(ctx *types.DownloaderContext, url *url.URL) error {
 		defer file2.Close()
 
 		// Downloading and copying the files
-		resp1, err := ytdlpbind.Request(res.RequestedFormats[0])
+		resp1, err := ytdlpbind.Request(ctx.Context(), res.RequestedFormats[0])
 		if err != nil {
 			return err
 		}
@@ -58,7 +58,7 @@ func Download(ctx *types.DownloaderContext, url *url.URL) error {
 		}
 		file1.Close()
 
-		resp2, err := ytdlpbind.Request(res.RequestedFormats[1])
+		resp2, err := ytdlpbind.Request(ctx.Context(), res.RequestedFormats[1])
 		if err != nil {
 			return err
 		}
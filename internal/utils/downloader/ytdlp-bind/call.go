@@ -8,9 +8,9 @@ import (
 	"github.com/lrstanley/go-ytdlp"
 )
 
-func Call(url string) (YtDlpJSON, error) {
+func Call(ctx context.Context, url string) (YtDlpJSON, error) {
 	yt := ytdlp.New().DumpJSON().Cookies("secrets/cookies.txt")
-	res, err := yt.Run(context.TODO(), url)
+	res, err := yt.Run(ctx, url)
 	if err != nil {
 		if res == nil {
 			return YtDlpJSON{}, err
@@ -1,7 +1,9 @@
 package ytdlpbind
 
 import (
+	"context"
 	"fmt"
+	"kano/internal/utils/downloader/types"
 	"net/http"
 	"slices"
 	"strings"
@@ -11,12 +13,12 @@ var ignoreKeys = []string{
 	"domain", "path", "secure", "expires",
 }
 
-func Request(format YtDlpFormat) (*http.Response, error) {
+func Request(ctx context.Context, format YtDlpFormat) (*http.Response, error) {
 	if format.Url == "" {
 		return nil, fmt.Errorf("empty url")
 	}
 
-	req, err := http.NewRequest("GET", format.Url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", format.Url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +51,5 @@ func Request(format YtDlpFormat) (*http.Response, error) {
 		fmt.Printf("%+v\n", req.Cookies())
 	}
 
-	cli := http.Client{}
-	return cli.Do(req)
+	return types.MediaClient().Do(req)
 }
@@ -0,0 +1,77 @@
+package httpclient
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Where clients with a CacheTTL keep their responses
+var CacheDir = filepath.Join("tmp", "httpcache")
+
+func cachePath(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + req.URL.String()))
+	return filepath.Join(CacheDir, hex.EncodeToString(sum[:]))
+}
+
+func (c *Client) cacheable(req *http.Request, resp *http.Response) bool {
+	return c.opts.CacheTTL > 0 && req.Method == http.MethodGet && resp.StatusCode == http.StatusOK
+}
+
+func (c *Client) cached(req *http.Request) (*http.Response, bool) {
+	if c.opts.CacheTTL <= 0 || req.Method != http.MethodGet {
+		return nil, false
+	}
+
+	path := cachePath(req)
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > c.opts.CacheTTL {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		return nil, false
+	}
+	return resp, true
+}
+
+// Reads the whole body so it can be written to disk, the caller gets an in
+// memory copy. Failing to write the cache only costs a refetch later.
+func (c *Client) store(req *http.Request, resp *http.Response) (*http.Response, error) {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	saved := *resp
+	saved.Body = io.NopCloser(bytes.NewReader(data))
+	saved.ContentLength = int64(len(data))
+	saved.TransferEncoding = nil
+
+	var buf bytes.Buffer
+	if err := saved.Write(&buf); err == nil && os.MkdirAll(CacheDir, 0755) == nil {
+		if tmp, err := os.CreateTemp(CacheDir, "*.tmp"); err == nil {
+			_, werr := tmp.Write(buf.Bytes())
+			tmp.Close()
+			if werr != nil || os.Rename(tmp.Name(), cachePath(req)) != nil {
+				os.Remove(tmp.Name())
+			}
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	resp.ContentLength = int64(len(data))
+	return resp, nil
+}
@@ -0,0 +1,260 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var ErrTooLarge = errors.New("response body is too large")
+
+type Options struct {
+	// Whole request including reading the body, 0 leaves it to the context
+	Timeout time.Duration
+	// Extra attempts after timeouts, dropped connections, 429 and gateway errors
+	MaxRetries int
+	// Wait before the first retry, doubled on every retry
+	BaseDelay time.Duration
+	// Upper bound of a single wait, Retry-After included
+	MaxDelay time.Duration
+	// Response body limit, 0 means no limit
+	MaxBodyBytes int64
+	// Requests running at once per host, 0 means no limit
+	PerHost int
+	// Successful GET responses are kept on disk this long, 0 disables the
+	// cache. Only meant for public resources, the key is the URL alone.
+	CacheTTL time.Duration
+	// Return 3xx responses as they are instead of following them
+	NoRedirect bool
+}
+
+var DefaultOptions = Options{
+	Timeout:      30 * time.Second,
+	MaxRetries:   3,
+	BaseDelay:    500 * time.Millisecond,
+	MaxDelay:     30 * time.Second,
+	MaxBodyBytes: 16 << 20,
+	PerHost:      4,
+}
+
+// Shared by every client so connections are pooled across them
+var transport = func() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.ResponseHeaderTimeout = 30 * time.Second
+	return t
+}()
+
+type Client struct {
+	opts Options
+	http *http.Client
+
+	mu    sync.Mutex
+	hosts map[string]chan struct{}
+}
+
+func New(opts Options) *Client {
+	c := &Client{
+		opts:  opts,
+		http:  &http.Client{Transport: transport, Timeout: opts.Timeout},
+		hosts: map[string]chan struct{}{},
+	}
+	if opts.NoRedirect {
+		c.http.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return c
+}
+
+// For callers without special needs
+var Default = New(DefaultOptions)
+
+func (c *Client) Options() Options {
+	return c.opts
+}
+
+func (c *Client) Get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
+
+// Do sends the request, retrying it when the upstream is flaky. The host slot
+// is held until the response body is closed, so always close it.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	if resp, ok := c.cached(req); ok {
+		return resp, nil
+	}
+
+	release, err := c.acquire(req.Context(), req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	if max := c.opts.MaxBodyBytes; max > 0 && resp.ContentLength > max {
+		resp.Body.Close()
+		release()
+		return nil, fmt.Errorf("%w: %d bytes, limit is %d", ErrTooLarge, resp.ContentLength, max)
+	}
+	resp.Body = &body{r: resp.Body, left: c.opts.MaxBodyBytes, limited: c.opts.MaxBodyBytes > 0, release: sync.OnceFunc(release)}
+
+	if c.cacheable(req, resp) {
+		return c.store(req, resp)
+	}
+	return resp, nil
+}
+
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			b, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = b
+		}
+
+		resp, err := c.http.Do(req)
+		// A body that can't be sent again means a single attempt
+		replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		if attempt >= c.opts.MaxRetries || !replayable || !retryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt, resp)
+		if resp != nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+			resp.Body.Close()
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// Cancelled by the caller, not the upstream's fault
+		if req.Context().Err() != nil {
+			return false
+		}
+		// Every *url.Error is a net.Error, so only the transient ones count
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return true
+		}
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Exponential with some jitter, or whatever the server asks for in
+// Retry-After
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := c.opts.BaseDelay << attempt
+	if wait > 0 {
+		wait += rand.N(wait/2 + 1)
+	}
+
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			wait = after
+		}
+	}
+
+	if c.opts.MaxDelay > 0 && wait > c.opts.MaxDelay {
+		wait = c.opts.MaxDelay
+	}
+	return wait
+}
+
+// Retry-After is either a number of seconds or an HTTP date
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(secs)*time.Second, 0), true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func (c *Client) acquire(ctx context.Context, host string) (func(), error) {
+	if c.opts.PerHost <= 0 {
+		return func() {}, nil
+	}
+
+	c.mu.Lock()
+	slots, ok := c.hosts[host]
+	if !ok {
+		slots = make(chan struct{}, c.opts.PerHost)
+		c.hosts[host] = slots
+	}
+	c.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Enforces the size limit and frees the host slot on close
+type body struct {
+	r       io.ReadCloser
+	left    int64
+	limited bool
+	release func()
+}
+
+func (b *body) Read(p []byte) (int, error) {
+	if !b.limited {
+		return b.r.Read(p)
+	}
+	if b.left <= 0 {
+		var probe [1]byte
+		if n, _ := b.r.Read(probe[:]); n > 0 {
+			return 0, ErrTooLarge
+		}
+		return 0, io.EOF
+	}
+	if int64(len(p)) > b.left {
+		p = p[:b.left]
+	}
+	n, err := b.r.Read(p)
+	b.left -= int64(n)
+	return n, err
+}
+
+func (b *body) Close() error {
+	defer b.release()
+	return b.r.Close()
+}
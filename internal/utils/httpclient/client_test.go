@@ -0,0 +1,173 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if d, ok := retryAfter("3", now); !ok || d != 3*time.Second {
+		t.Errorf("expected 3s, got %s %t", d, ok)
+	}
+	if d, ok := retryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now); !ok || d != 10*time.Second {
+		t.Errorf("expected 10s, got %s %t", d, ok)
+	}
+	if _, ok := retryAfter("soon", now); ok {
+		t.Errorf("invalid value should be ignored")
+	}
+}
+
+func TestRetries(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	c := New(Options{MaxRetries: 3, BaseDelay: time.Hour, MaxDelay: time.Second})
+	resp, err := c.Get(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || hits.Load() != 3 {
+		t.Errorf("expected 200 after 3 hits, got %d after %d", resp.StatusCode, hits.Load())
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Chunked, so the limit can't be checked up front
+		w.(http.Flusher).Flush()
+		io.WriteString(w, strings.Repeat("a", 100))
+	}))
+	defer srv.Close()
+
+	c := New(Options{MaxBodyBytes: 10})
+	resp, err := c.Get(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.ReadAll(resp.Body); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("expected ErrTooLarge, got %v", err)
+	}
+}
+
+func TestCache(t *testing.T) {
+	CacheDir = t.TempDir()
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		io.WriteString(w, "cached")
+	}))
+	defer srv.Close()
+
+	c := New(Options{CacheTTL: time.Minute})
+	for range 2 {
+		resp, err := c.Get(context.Background(), srv.URL)
+		if err != nil {
+			t.Fatalf("request failed: %s", err)
+		}
+		data, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(data) != "cached" {
+			t.Errorf("unexpected body %q", data)
+		}
+	}
+
+	if hits.Load() != 1 {
+		t.Errorf("expected the second request to be served from the cache, server got %d hits", hits.Load())
+	}
+}
+
+func TestRetriesRunOut(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := New(Options{MaxRetries: 2, BaseDelay: time.Hour, MaxDelay: time.Second})
+	resp, err := c.Get(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	defer resp.Body.Close()
+
+	// Callers get the last response and have to check the status themselves
+	if resp.StatusCode != http.StatusTooManyRequests || hits.Load() != 3 {
+		t.Errorf("expected 429 after 3 hits, got %d after %d", resp.StatusCode, hits.Load())
+	}
+}
+
+func TestNoRetryOnPermanentError(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		// Following it fails with a *url.Error that is no network hiccup
+		http.Redirect(w, r, "ftp://example.com/", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	c := New(Options{MaxRetries: 3, BaseDelay: time.Millisecond})
+	resp, err := c.Get(context.Background(), srv.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("expected the redirect to fail")
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("expected a single attempt, got %d", n)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com", nil)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		req      *http.Request
+		err      error
+		expected bool
+	}{
+		{"timeout", req, &url.Error{Op: "Get", URL: "x", Err: context.DeadlineExceeded}, true},
+		{"connection_reset", req, &url.Error{Op: "Get", URL: "x", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, true},
+		{"connection_refused", req, &url.Error{Op: "Get", URL: "x", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, true},
+		{"unexpected_eof", req, &url.Error{Op: "Get", URL: "x", Err: io.ErrUnexpectedEOF}, true},
+		{"unsupported_scheme", req, &url.Error{Op: "Get", URL: "x", Err: errors.New("unsupported protocol scheme")}, false},
+		{"dns", req, &url.Error{Op: "Get", URL: "x", Err: &net.DNSError{Err: "no such host", IsNotFound: true}}, false},
+		{"cancelled_by_caller", req.WithContext(cancelled), &url.Error{Op: "Get", URL: "x", Err: context.Canceled}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(tt.req, nil, tt.err); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,424 @@
+package i18n
+
+var en = map[MessageID]string{
+	// Common
+	"common.internal_error":  "Internal error.\nDebug: %s",
+	"common.something_wrong": "Something went wrong\nDebug: %s",
+	"common.done":            "Done",
+
+	// Dispatch
+	"access.group_only":         "This command can only be used in group chats.",
+	"access.private_only":       "This command can only be used in private chat.",
+	"access.owner_only":         "This command can only be executed by the bot owner.",
+	"access.admin_only":         "This command can only be executed by group admins.",
+	"access.role_unknown":       "Unable to check your role in this group, try again later.",
+	"access.participant_failed": "Failed to get participant info: %s",
+	"access.settings_failed":    "Unable to load this group settings, try again later.",
+	"access.feature_disabled":   "Feature `%s` is disabled in this group. Ask an admin to run `%[2]senable %[1]s`.",
+	"ratelimit.slow_down":       "Slow down, try again in %ds.",
+	"suggest.did_you_mean":      "Command `%s` not found. Did you mean `%s%s`?",
+	"args.invalid":              "Invalid %s.\n\n%s",
+	"args.usage":                "Usage:",
+
+	// Help
+	"help.not_found":          "Command \"%s\" is not found!",
+	"help.sub_not_found":      "Subcommand \"%s\" of \"%s\" is not found!",
+	"help.empty":              "Docs entry for \"%s\" is empty",
+	"help.main":               "Welcome to my most simple help message!\n_Tbh, I don't know how to design a help message, try `%s %s` for more info, I guess._\nCommand prefixes currently used include:\n%s\n\nBelow are the available commands by category, use `%s _category_` to see what they do:\n%s\nSource code bot: https://github.com/ziprawan/opc-kano",
+	"help.category_page":      "*%s*\n%s\n\nUse `%s _command_` for the manual of a command.",
+	"help.category.academic":  "Academic",
+	"help.category.games":     "Games",
+	"help.category.media":     "Media",
+	"help.category.utilities": "Utilities",
+	"help.category.admin":     "Administration",
+	"help.category.other":     "Other",
+	"help.page_next":          "_Page %d of %d, send `%s page=%d` for the next one._",
+	"help.page_last":          "_Page %d of %d._",
+	"help.page_invalid":       "Page %d doesn't exist, there are only %d pages.",
+
+	// Group features
+	"enable.current":     "Current configuration:\n%s",
+	"enable.saved":       "Configuration saved! Current configuration:\n%s",
+	"enable.invalid":     "Unknown feature `%s`. Available features: %s.",
+	"enable.save_failed": "Failed to save group settings: %s",
+	"enable.on":          "on",
+	"enable.off":         "off",
+	"feature.game":       "Games such as wordle and sawit",
+	"feature.confess":    "Anonymous confessions to this group",
+	"feature.suggest":    "Suggest the closest command on typos",
+	"feature.download":   "Media downloader",
+	"feature.stk":        "Sticker maker",
+	"feature.ta":         "Tag every member",
+	"feature.vo":         "View once opener",
+
+	// Language
+	"lang.current":      "Current language: %s%s\nAvailable: %s",
+	"lang.default_mark": " (default)",
+	"lang.invalid":      "Unknown language `%s`. Available: %s",
+	"lang.saved":        "Language saved! Current language: %s",
+	"lang.save_failed":  "Failed to save language: %s",
+	"lang.invalid_sub":  "Invalid lang command: %s\nUse `%shelp lang` for the list of subcommands.",
+
+	// SIX
+	"six.invalid":                  "Invalid SIX command: %s\nUse `%shelp six` for the list of commands.",
+	"six.sender_failed":            "Failed to get the user ID %q",
+	"six.class_not_found":          "Cannot find subject %s class %02d. If this is a mistake, try contacting the bot owner.",
+	"six.internal_error":           "Internal error, please report it to the bot owner right away.\nAdditional info: %s",
+	"six.bad_code":                 "Invalid class code %q: %s",
+	"six.follow.failed":            "Failed to follow the class: internal error, please report it to the bot owner right away.\nAdditional info: %s",
+	"six.follow.already":           "Already following %s-%02d (%s).",
+	"six.follow.success":           "Now following %s-%02d (%s).",
+	"six.follow.report":            "Follow results:",
+	"six.follow.empty":             "You are not following any class yet. Use `%ssix follow` _class_code_ to start following one.",
+	"six.follow.list_header":       "Classes you follow (%d):",
+	"six.unfollow.report":          "Unfollow results:",
+	"six.unfollow.success":         "No longer following %s-%02d (%s).",
+	"six.unfollow.not_following":   "Not following %s-%02d (%s).",
+	"six.unfollow.all":             "Stopped following %d classes.",
+	"six.reminder.bad_offset":      "Invalid offset format: %s. Valid examples: `+10`, `^-20`, `-30m`, `^`",
+	"six.reminder.offset_range":    "The offset is too large or too small. The minimum is -1 week (-%d minutes) and the maximum is 1 week (%d minutes). Got: %d minutes",
+	"six.reminder.class_failed":    "Failed to get the class ID: internal error, please report it to the bot owner right away.\nAdditional info: %s",
+	"six.reminder.add_failed":      "Failed to add the class reminder: internal error, please report it to the bot owner right away.\nAdditional info: %s",
+	"six.reminder.describe":        "%d minutes %s class %s-%02d (%s) %s",
+	"six.reminder.exists":          "Reminder %q was already added.",
+	"six.reminder.added":           "Added reminder %q.",
+	"six.reminder.list_failed":     "Failed to get the reminders, please report it to the bot owner.\nAdditional info: `%s`",
+	"six.reminder.empty":           "No reminders set. See `%s help reminder` for more info.",
+	"six.reminder.list_header":     "Reminders:",
+	"six.reminder.list_item":       "%s the class %s",
+	"six.reminder.days":            "%d days ",
+	"six.reminder.hours":           "%d hours ",
+	"six.reminder.minutes":         "%d minutes ",
+	"six.reminder.before":          "before",
+	"six.reminder.after":           "after",
+	"six.reminder.at":              "at",
+	"six.reminder.exactly_at":      "right when",
+	"six.reminder.starts":          "starts",
+	"six.reminder.ends":            "ends",
+	"six.reminder.remove_usage":    "Use `%ssix reminder remove` _class_code_ [ _offset_ ] or `%ssix reminder remove all`.",
+	"six.reminder.remove_none":     "No matching reminder to remove.",
+	"six.reminder.removed":         "Removed %d reminders of %s-%02d (%s).",
+	"six.reminder.removed_all":     "Removed %d reminders.",
+	"six.reminder.pause_usage":     "Give the pause limit, e.g. `%ssix reminder pause 2026-03-01` or `%ssix reminder pause 7d`.",
+	"six.reminder.bad_date":        "Invalid pause limit %q. Use a YYYY-MM-DD or DD-MM-YYYY date, or a duration like `7d`.",
+	"six.reminder.date_past":       "The pause limit %s has already passed.",
+	"six.reminder.paused":          "All reminders are paused until %s.",
+	"six.reminder.muted":           "All reminders are muted. Use `%ssix reminder resume` to turn them back on.",
+	"six.reminder.resumed":         "Reminders are active again.",
+	"six.reminder.status_muted":    "_All reminders are muted, use `%ssix reminder resume` to turn them back on._",
+	"six.reminder.status_paused":   "_All reminders are paused until %s._",
+	"six.reminder.snoozed":         "Reminder snoozed for %d minutes.",
+	"six.reminder.alert":           "Reminder: %s%s the class %s-%02d (%s) %s",
+	"six.reminder.fetch_failed":    "SixReminder: Failed to get the reminders: %s",
+	"six.reminder.delivery_failed": "SixReminder: Failed to save the deliveries: %s",
+	"six.digest.status":            "Timetable digest: %s.\nUse `%ssix digest daily|weekly|both|off` to change it.",
+	"six.digest.saved":             "Timetable digest set: %s.",
+	"six.digest.invalid":           "Invalid digest option %q, use daily, weekly, both or off.",
+	"six.digest.daily":             "daily every morning",
+	"six.digest.weekly":            "weekly every Sunday evening",
+	"six.digest.both":              "daily every morning and weekly every Sunday evening",
+	"six.digest.off":               "off",
+	"six.digest.today":             "Today's classes, %s:",
+	"six.digest.next_week":         "Next week's classes, %s to %s:",
+	"six.jadwal.bad_when":          "Invalid option %q, use today, week or a date like 2025-02-17.",
+	"six.jadwal.day":               "Schedule for %s:",
+	"six.jadwal.week":              "Schedule from %s to %s:",
+	"six.jadwal.empty_day":         "None of the classes you follow have a schedule on %s.",
+	"six.jadwal.empty_week":        "None of the classes you follow have a schedule from %s to %s.",
+	"six.cek.need_more":            "Give at least two different class codes, e.g. `%ssix cek ET2202-01 MA1101-03`.",
+	"six.cek.none":                 "No schedule conflicts between %s.",
+	"six.cek.found":                "Found %d pairs of classes with conflicting schedules:",
+	"six.cek.conflict":             "%s and %s overlap %d times, first on %s",
+	"six.cek.no_schedule":          "%s has no schedule yet, so it could not be checked.",
+
+	// Schedules
+	"schedules.updates_header":      "There are changes to the classes you follow:",
+	"schedules.class_removed":       "This class was removed from the timetable, you no longer follow it",
+	"schedules.subject_removed":     "This subject was removed from the timetable, you no longer follow its class",
+	"schedules.number_changed":      "Class number changed from %02d to %02d",
+	"schedules.quota_changed":       "Quota changed from %s to %s",
+	"schedules.quota_none":          "none",
+	"schedules.constraints_changed": "Enrollment constraints changed",
+	"schedules.lecturers_added":     "New lecturers: %s",
+	"schedules.lecturers_removed":   "Lecturers no longer teaching: %s",
+	"schedules.teams_added":         "New Teams link: %s",
+	"schedules.teams_removed":       "Teams link removed",
+	"schedules.edunex_added":        "New Edunex link: https://edunex.itb.ac.id/courses/%d",
+	"schedules.edunex_removed":      "Edunex link removed",
+	"schedules.method_changed":      "%s %s is now %s",
+	"schedules.moved":               "%s moved from %s to %s",
+	"schedules.added":               "New schedule: %s %s",
+	"schedules.removed":             "Schedule removed: %s %s",
+	"schedules.in_rooms":            " in %s",
+	"schedules.more_moved":          "...and %d more schedules moved",
+	"schedules.more_added":          "...and %d more new schedules",
+	"schedules.more_removed":        "...and %d more schedules removed",
+	"schedules.rooms_added":         "added %s",
+	"schedules.rooms_removed":       "removed %s",
+	"schedules.room_changed":        "Rooms of %s %s: %s",
+	"schedules.room_changed_many":   "Rooms of %s in %d meetings from %s: %s",
+	"schedules.activity.lecture":    "Lecture",
+	"schedules.activity.tutorial":   "Tutorial",
+	"schedules.activity.lab_work":   "Lab work",
+	"schedules.activity.quiz":       "Quiz",
+	"schedules.activity.midterm":    "Midterm",
+	"schedules.activity.final":      "Final exam",
+	"schedules.activity.other":      "Activity",
+	"schedules.method.in_person":    "in person",
+	"schedules.method.online":       "online",
+	"schedules.method.hybrid":       "hybrid",
+	"schedules.method.other":        "using another method",
+	"schedules.weekday.0":           "Sunday",
+	"schedules.weekday.1":           "Monday",
+	"schedules.weekday.2":           "Tuesday",
+	"schedules.weekday.3":           "Wednesday",
+	"schedules.weekday.4":           "Thursday",
+	"schedules.weekday.5":           "Friday",
+	"schedules.weekday.6":           "Saturday",
+	"schedules.month.1":             "Jan",
+	"schedules.month.2":             "Feb",
+	"schedules.month.3":             "Mar",
+	"schedules.month.4":             "Apr",
+	"schedules.month.5":             "May",
+	"schedules.month.6":             "Jun",
+	"schedules.month.7":             "Jul",
+	"schedules.month.8":             "Aug",
+	"schedules.month.9":             "Sep",
+	"schedules.month.10":            "Oct",
+	"schedules.month.11":            "Nov",
+	"schedules.month.12":            "Dec",
+
+	// Sawit
+	"sawit.invalid":                "Invalid sawit command %s",
+	"sawit.get_failed":             "Failed to get participant's sawit: %s",
+	"sawit.save_failed":            "Failed to save participant's sawit: %s",
+	"sawit.rank_failed":            "Failed to get participant sawit's rank position: %s",
+	"sawit.participant_failed":     "Failed to get participant info: %s",
+	"sawit.list_failed":            "Failed to get sawits: %s",
+	"sawit.empty":                  "Nobody grows sawit here :(\nNo wowo impressed.",
+	"sawit.unknown_user":           "[Unknown User: %s]",
+	"sawit.leaderboard.top":        "Top of the tallest sawits:\n\n",
+	"sawit.leaderboard.bottom":     "Top of the shortest sawits:\n\n",
+	"sawit.leaderboard.legend":     "\n_[+] means a grower hasn't grown his sawit today yet._",
+	"sawit.attack.too_big":         "Your attack size is higher than your sawit height (%d > %d)",
+	"sawit.attack.challenge":       "%s challenged the chat with *%d* cm!\nReact with any emoji to accept the challenge.",
+	"sawit.attack.save_failed":     "Failed to save sawit attack info: %s",
+	"sawit.grow.already":           "You already grew your sawit today.\nWait for *%dh %dm*",
+	"sawit.grow.grown":             "Your sawit has grown by *%d cm* and now it is has *%d cm* height.\nYour position in the top is %d.\n\nNext grower in *%dh %dm*",
+	"sawit.grow.shrunk":            "Your sawit has shrunk by *%d cm* and now it is has *%d cm* height.\nYour position in the top is %d.\n\nNext grower in *%dh %dm*",
+	"sawit.grow.too_deep":          "Dawg, why are your sawit is so deep, I can only give you 100 cm for now. Your sawit now is *%d cm* height\nYour position in the top is %d.\n\nNext grower in *%dh %dm*",
+	"sawit.grow.negative":          "Your sawit height is negative, huh? I think I will just make it *0 cm* height.\nYour position in the top is %d.\n\nNext grower in *%dh %dm*",
+	"sawit.stat":                   "Height: *%d*\nPosition in the top: *%d*\n\nWin rate: *%.02f%%*\nAttacks: *%d*\nWins: *%d*\nAcquired height: *%d cm*\nLost height: *%d cm*",
+	"sawit.transfer.invalid":       "Invalid transfer amount",
+	"sawit.transfer.too_big":       "Your transfer size is higher than your sawit height (%d > %d)",
+	"sawit.transfer.target_failed": "Failed to get target participant: %s",
+	"sawit.transfer.target_sawit":  "Failed to get target participant's sawit: %s",
+	"sawit.transfer.success":       "Successfully transferred *%d* cm from %s to %s!",
+	"sawit.duel.negative":          "Dear, %s, your sawit height is negative, go pay your debt buddy 😭🙏",
+	"sawit.duel.empty":             "Dear, %s, you don't have any sawit right now",
+	"sawit.duel.placeholder":       "This is placeholder message, if you are seeing this, maybe the replied message is too old.",
+	"sawit.duel.result":            "The winner is *%s*! His sawit is now *%d cm* long. The loser's one is *%d cm*\n\n*%s*'s position in the top is *%d*.\n*%s*'s position in the top is *%d*.\n\nWin rate of the *winner — %.02f%%*\nWin rate of the *loser — %.02f%%*",
+
+	// Wordle
+	"wordle.get_failed":   "Failed to get user wordle: %s",
+	"wordle.set_failed":   "Failed to set user wordle: %s",
+	"wordle.save_failed":  "failed to save user wordle guesses: %s",
+	"wordle.image_failed": "failed to generate wordle image: %s",
+	"wordle.done":         "Your wordle is correct for today (%s), please wait for %s",
+	"wordle.out":          "Your attempts is over, the answer is %s",
+	"wordle.too_short":    "Word length is too short",
+	"wordle.not_exists":   "Word %q doesn't exists",
+	"wordle.first_try":    "Impressive, done in just one guess",
+	"wordle.last_try":     "Your guess was just right, luckily it was right",
+	"wordle.correct":      "Yeay, your guess was correct!",
+	"wordle.over":         "The guess is over, the answer is %s",
+	"wordle.wrong":        "Yah, your guess is wrong, try again (%d/6)",
+	"wordle.start":        "Send %swordle [YOUR_GUESS] to guess the word for today!",
+	"wordle.continue":     "Send %swordle [YOUR_GUESS] to continue your guess!",
+
+	// LINE stickers
+	"stkline.no_url":               "Give the sticker store url",
+	"stkline.bad_url":              "Given url is not parsable",
+	"stkline.bad_scheme":           `Given url scheme is not "https"`,
+	"stkline.bad_host":             `Given url host is not "store.line.me"`,
+	"stkline.store_home":           "Given url is the store home",
+	"stkline.bad_shop":             "Shop type is unsupported, ensure the given url is a stickershop or emojishop. Got: %s",
+	"stkline.no_id":                "Unable to get sticker/emoji id, is your given url valid?",
+	"stkline.fetch_failed":         "Failed to fetch store page",
+	"stkline.read_failed":          "Failed to read store page: %s",
+	"stkline.no_name":              "Failed to get sticker/emoji name",
+	"stkline.no_author":            "Failed to get sticker/emoji author",
+	"stkline.cover_count":          "Unable to find sticker/emoji cover: expected 1, got %d",
+	"stkline.cover_no_preview":     "Unable to find sticker/emoji cover: cannot get data-preview attribute",
+	"stkline.cover_invalid":        "Unable to find sticker/emoji cover: invalid data",
+	"stkline.cover_fetch_failed":   "Unable to find sticker/emoji cover: failed to fetch cover image: %s",
+	"stkline.cover_png_failed":     "Unable to find sticker/emoji cover: failed to read cover image as png: %s",
+	"stkline.cover_webp_failed":    "Unable to find sticker/emoji cover: failed to convert cover image to webp: %s",
+	"stkline.cover_zip_failed":     "Failed to add cover image to the zip: %s",
+	"stkline.found":                "Sticker name: %q\nStickers found: %d\n\nPlease wait...",
+	"stkline.item_missing":         "Failed to get sticker/emoji data at index %d",
+	"stkline.item_parse_failed":    "Failed to parse sticker/emoji data at index %d: %s",
+	"stkline.item_no_url":          "Unexpected empty sticker/emoji url at index %d",
+	"stkline.item_download_failed": "Failed to download the sticker/emoji at index %d",
+	"stkline.item_download_error":  "Failed to download the sticker/emoji at index %d: %s",
+	"stkline.apng_failed":          "Failed to read sticker/emoji as animated PNG file: %s",
+	"stkline.png_header_failed":    "Failed to get PNG header: %s",
+	"stkline.webp_encode_failed":   "Failed to encode as webp: %s",
+	"stkline.webp_modify_failed":   "Failed to modify the webp: %s",
+	"stkline.webp_build_failed":    "Failed to build webp after modification: %s",
+	"stkline.failed":               "Failed: %s",
+	"stkline.metadata_failed":      "Failed to append metadata: %s",
+	"stkline.sticker_failed":       "Failed to make sticker: %s",
+	"stkline.zip_failed":           "Failed to add sticker/emoji to a zip: %s",
+	"stkline.upload_failed":        "Failed to upload sticker pack into the server: %s",
+
+	// Admin
+	"admin.invalid":                "Invalid admin command: %s\nUse `%shelp admin` for the list of subcommands.",
+	"admin.summary":                "Owner only mode: %t\nSubcommands: %s",
+	"admin.reload_failed":          "Failed to reload config, the old one is kept: %s",
+	"admin.reloaded":               "Config reloaded.\nOwner: %s\nOwner only mode: %t",
+	"admin.owneronly.current":      "Owner only mode: %t",
+	"admin.invalid_state":          "Invalid state `%s`, use `on` or `off`.",
+	"admin.owneronly.set":          "Owner only mode: %t\nThis lasts until the next reload or restart.",
+	"admin.groups.failed":          "Failed to get joined groups: %s",
+	"admin.groups.empty":           "The bot is not in any group.",
+	"admin.leave.bad_number":       "Group number must be between 1 and %d.",
+	"admin.leave.bad_group":        "Invalid group `%s`, give its number from `admin groups` or its JID.",
+	"admin.leave.failed":           "Failed to leave %s: %s",
+	"admin.leave.done":             "Left %s.",
+	"admin.broadcast.empty":        "Give the message to broadcast.",
+	"admin.broadcast.failed":       "Failed to get followers: %s",
+	"admin.broadcast.no_followers": "Nobody follows any class yet.",
+	"admin.broadcast.start":        "Broadcasting to %d followers, this takes about %s.",
+	"admin.broadcast.done":         "Broadcast sent to %d of %d followers.",
+	"admin.broadcast.stopped":      "Broadcast stopped after %d of %d followers: %s",
+	"admin.block.bad_target":       "Invalid target `%s`: %s",
+	"admin.block.refused":          "Refusing to block the owner or the bot itself.",
+	"admin.block.bad_duration":     "Duration must be positive.",
+	"admin.block.failed":           "Failed to block %s: %s",
+	"admin.block.done":             "Blocked %s %s, the bot ignores it everywhere now.",
+	"admin.unblock.failed":         "Failed to unblock %s: %s",
+	"admin.unblock.not_blocked":    "%s is not blocked.",
+	"admin.unblock.done":           "Unblocked %s.",
+	"admin.blocks.failed":          "Failed to get the blocklist: %s",
+	"admin.blocks.empty":           "The blocklist is empty.",
+	"admin.groups.header":          "*Joined groups (%d)*\n",
+	"admin.groups.entry":           "%d. %s\n   %s, %d members",
+	"admin.status.queue":           "*Queue*",
+	"admin.status.pool":            "Workers: %d (%d running)\nQueued: %d/%d\nRejected: %d",
+	"admin.status.not_started":     "Not started",
+	"admin.status.cron":            "*Cron jobs*",
+	"admin.status.no_cron":         "None",
+	"admin.status.never":           "never",
+	"admin.status.job":             "- *%s* `%s`\n  Next: %s\n  Last: %s (%s), %d runs",
+	"admin.status.running":         ", running now",
+	"admin.loglevel.default":       "Default log level: *%s*",
+	"admin.loglevel.temporary":     "This lasts until the next reload or restart.",
+	"admin.blocks.header":          "*Blocklist (%d)*\n",
+	"admin.blocks.no_reason":       "no reason",
+	"admin.block.forever":          "forever",
+	"admin.block.until":            "until %s",
+
+	// Confess
+	"confess.no_group":           "You are not joining groups or not all group allowing confess.",
+	"confess.many_groups":        "I seem to see you in multiple groups, please set it up first using `.confesstarget`",
+	"confess.empty":              "Give the confess message.",
+	"confess.target.save_failed": "Failed to save contact info: %s",
+	"confess.target.saved":       "Succesfully set the confess target into %d - %q",
+	"confess.target.usage":       "Use `.confesstarget group_id` to set the confess target.",
+	"confess.target.current":     "Your current selection: %d - %q",
+	"confess.target.available":   "Available groups:",
+
+	// Game
+	"game.current":  "Is game allowed in this group? %t",
+	"game.invalid":  "Input is not valid.\nUse %s to enable.\nUse %s to disable.",
+	"game.both":     "Internal error: isEnabled and isDisabled are both true.",
+	"game.enabled":  "Game is enabled in this group from now.",
+	"game.disabled": "Game is disabled in this group from now.",
+
+	// Download
+	"download.no_url":        "Give url (currently supports: instagram, tiktok, youtube[pls don't])",
+	"download.no_youtube":    "Youtube support is currently dropped",
+	"download.upload_failed": "failed to upload media: %s",
+
+	// Sticker
+	"stk.not_media":        "Given message is not a media. Don't do that again.",
+	"stk.view_once":        "Given media is a view once. Don't do that again.",
+	"stk.not_downloadable": "Given media is not downloadable, please resend it.\nDebug info: %s",
+	"stk.download_failed":  "Unable to download media. Please resend it.\nDebug info: %s",
+	"stk.convert_failed":   "Failed to convert media into sticker. This is an internal error, try again later.\nDebug info: %s",
+
+	// View once
+	"vo.no_reply":          "Please reply to a view-once message.",
+	"vo.query_failed":      "Failed to query to vo_request table: %s",
+	"vo.not_view_once":     "Replied message is not a view-once message.",
+	"vo.missing_fields":    "Replied view once has missing fields. Most likely you are using the latest WhatsApp version.\nDebug info: %s",
+	"vo.save_failed":       "Failed to save into vo_request table: %s",
+	"vo.already_requested": "View-once message is already requested by @%s",
+	"vo.already_accepted":  "View-once message is already requested by @%s and already accepted by the sender",
+	"vo.already_denied":    "View-once message is already requested by @%s and already denied by the sender",
+	"vo.waiting_approval":  "Waiting for approval from @%s (react with ✅ to approve, ❌ or just leave it alone to reject)",
+	"vo.denied":            "Request denied.",
+	"vo.placeholder":       "Reply placeholder. If you are seeing this, maybe your app is broken for some reason.",
+
+	// Redirect
+	"redirect.failed": "Request error\nDebug: %s",
+
+	// PDDikti
+	"pddikti.no_key":    "This command is not initialized by the owner.\nDebug: Missing PDDIKTI_KEY or PDDIKTI_IV",
+	"pddikti.not_found": "Not found",
+
+	// IGRS
+	"igrs.request_failed": "Internal error while creating request object",
+	"igrs.slow":           "It seems the request is taking longer than expected, please wait",
+	"igrs.fetch_failed":   "Request failed: %v",
+	"igrs.api_status":     "API returned %s",
+	"igrs.decode_failed":  "Internal error while reading the response with error %s",
+	"igrs.no_name":        "Give the game name",
+	"igrs.not_found":      "Game not found",
+	"igrs.no_id":          "Give the game id (positive number)",
+	"igrs.bad_id":         "Given ID is not a number",
+
+	// Resolve subject
+	"resolve.usage":           "Give argument (e.g. .resolve-subject ET1201=12345 ET1202=23455)",
+	"resolve.failed":          "Something is wrong: %s",
+	"resolve.bad_value_count": "Expected value length is 1, got %d",
+	"resolve.bad_id":          "Unable to parse %q as uint: %s",
+	"resolve.bad_code_length": "Expected code length is 6, got %d",
+	"resolve.bad_code":        "Invalid code %s",
+	"resolve.id_taken":        "Subject id %d is already taken by %s, please check again the id for %s",
+
+	// Refresh groups
+	"refresh.groups_failed":       "Failed to get group list: %s",
+	"refresh.participants_failed": "Failed to get participant list for group JID %s: %s",
+	"refresh.info_failed":         "Failed to get group info for JID %s: %s",
+	"refresh.contact_failed":      "Failed to get/create contact info for JID %s: %s",
+	"refresh.save_failed":         "Failed to save participant with contact %s group %s: %s",
+	"refresh.update_failed":       "Failed to update participant with contact %s group %s: %s",
+
+	// Stats
+	"stats.bad_window": "Window must be positive.",
+	"stats.failed":     "Failed to get command stats: %s",
+	"stats.empty":      "No commands were dispatched in the last %s.",
+	"stats.header":     "*Command stats, last %s*\n",
+	"stats.entry":      "%d. *%s*: %dx, %.1f%% errors, p95 %s",
+
+	// NIM
+	"nim.query_failed": "Failed to query to database: %s\nQuery time: %d ms",
+	"nim.found":        "Found %d students\nQuery time: %d ms",
+	"nim.entry":        "Name: %s\nNIM: %d\nMajor - Faculty: %s - %s",
+
+	// Prefix
+	"prefix.invalid":       "Invalid prefix command: %s\nUse `%shelp prefix` for the list of subcommands.",
+	"prefix.load_failed":   "Failed to load prefix settings: %s",
+	"prefix.bad_prefix":    "Invalid prefix `%s`: %s.",
+	"prefix.too_many":      "Too many prefixes, at most %d are allowed.",
+	"prefix.invalid_state": "Invalid state `%s`, use `on` or `off`.",
+	"prefix.save_failed":   "Failed to save prefix settings: %s",
+	"prefix.saved":         "Prefix settings saved! %s",
+	"prefix.current":       "Current prefixes: %s",
+	"prefix.mention":       "Trigger by mention: %t",
+	"prefix.bad_length":    "must be 1 to %d characters long",
+	"prefix.bad_alnum":     "letters, digits and spaces are not allowed",
+	"prefix.bad_symbol":    "quotes, `=` and `@` are not allowed",
+}
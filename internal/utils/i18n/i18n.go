@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Lang string
+
+const (
+	LangID Lang = "id"
+	LangEN Lang = "en"
+)
+
+// Used when neither the group nor the contact picked a language
+const Default = LangID
+
+// Keys of the message catalog, grouped by the command that uses them,
+// e.g. "six.follow.success"
+type MessageID string
+
+var bundles = map[Lang]map[MessageID]string{
+	LangID: id,
+	LangEN: en,
+}
+
+func Langs() []Lang {
+	return []Lang{LangID, LangEN}
+}
+
+func Parse(s string) (Lang, bool) {
+	lang := Lang(strings.ToLower(s))
+	_, ok := bundles[lang]
+	return lang, ok
+}
+
+// Looks up the message in the given language, falling back to the default
+// one and then to the ID itself. args are applied with fmt.Sprintf.
+func T(lang Lang, msgId MessageID, args ...any) string {
+	msg, ok := bundles[lang][msgId]
+	if !ok {
+		msg, ok = bundles[Default][msgId]
+	}
+	if !ok {
+		return string(msgId)
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
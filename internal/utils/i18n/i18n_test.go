@@ -0,0 +1,37 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func verbs(msg string) int {
+	return strings.Count(msg, "%") - 2*strings.Count(msg, "%%")
+}
+
+func TestBundlesMatch(t *testing.T) {
+	for _, lang := range Langs() {
+		for msgId, msg := range bundles[Default] {
+			other, ok := bundles[lang][msgId]
+			if !ok {
+				t.Errorf("%s: missing %s", lang, msgId)
+				continue
+			}
+			if verbs(other) != verbs(msg) {
+				t.Errorf("%s: %s has %d verbs, %s has %d", lang, msgId, verbs(other), Default, verbs(msg))
+			}
+		}
+		if len(bundles[lang]) != len(bundles[Default]) {
+			t.Errorf("%s has %d messages, %s has %d", lang, len(bundles[lang]), Default, len(bundles[Default]))
+		}
+	}
+}
+
+func TestFallback(t *testing.T) {
+	if got := T(Lang("xx"), "six.follow.success", "ET2202", 1, "Sinyal"); got != "Berhasil mengikuti ET2202-01 (Sinyal)." {
+		t.Errorf("unexpected fallback %q", got)
+	}
+	if got := T(LangEN, "missing.id"); got != "missing.id" {
+		t.Errorf("unexpected missing message %q", got)
+	}
+}
@@ -0,0 +1,424 @@
+package i18n
+
+var id = map[MessageID]string{
+	// Common
+	"common.internal_error":  "Terjadi kesalahan internal.\nDebug: %s",
+	"common.something_wrong": "Terjadi kesalahan\nDebug: %s",
+	"common.done":            "Selesai",
+
+	// Dispatch
+	"access.group_only":         "Perintah ini hanya bisa digunakan di grup.",
+	"access.private_only":       "Perintah ini hanya bisa digunakan di private chat.",
+	"access.owner_only":         "Perintah ini hanya bisa dijalankan oleh pemilik bot.",
+	"access.admin_only":         "Perintah ini hanya bisa dijalankan oleh admin grup.",
+	"access.role_unknown":       "Tidak dapat memeriksa peranmu di grup ini, coba lagi nanti.",
+	"access.participant_failed": "Gagal mengambil info partisipan: %s",
+	"access.settings_failed":    "Gagal memuat pengaturan grup ini, coba lagi nanti.",
+	"access.feature_disabled":   "Fitur `%s` dinonaktifkan di grup ini. Minta admin untuk menjalankan `%[2]senable %[1]s`.",
+	"ratelimit.slow_down":       "Pelan-pelan, coba lagi dalam %d detik.",
+	"suggest.did_you_mean":      "Perintah `%s` tidak ditemukan. Mungkin maksudnya `%s%s`?",
+	"args.invalid":              "%s tidak valid.\n\n%s",
+	"args.usage":                "Penggunaan:",
+
+	// Help
+	"help.not_found":          "Perintah \"%s\" tidak ditemukan!",
+	"help.sub_not_found":      "Subperintah \"%s\" dari \"%s\" tidak ditemukan!",
+	"help.empty":              "Dokumentasi untuk \"%s\" masih kosong",
+	"help.main":               "Selamat datang di pesan bantuan paling sederhana!\n_Jujur, aku nggak tahu cara mendesain pesan bantuan, coba `%s %s` untuk info lebih lanjut._\nPrefix perintah yang sedang dipakai:\n%s\n\nBerikut daftar perintah per kategori, gunakan `%s _kategori_` untuk melihat kegunaannya:\n%s\nKode sumber bot: https://github.com/ziprawan/opc-kano",
+	"help.category_page":      "*%s*\n%s\n\nGunakan `%s _perintah_` untuk membaca manual sebuah perintah.",
+	"help.category.academic":  "Akademik",
+	"help.category.games":     "Permainan",
+	"help.category.media":     "Media",
+	"help.category.utilities": "Utilitas",
+	"help.category.admin":     "Administrasi",
+	"help.category.other":     "Lainnya",
+	"help.page_next":          "_Halaman %d dari %d, kirim `%s page=%d` untuk halaman berikutnya._",
+	"help.page_last":          "_Halaman %d dari %d._",
+	"help.page_invalid":       "Halaman %d tidak ada, hanya ada %d halaman.",
+
+	// Group features
+	"enable.current":     "Konfigurasi saat ini:\n%s",
+	"enable.saved":       "Konfigurasi disimpan! Konfigurasi saat ini:\n%s",
+	"enable.invalid":     "Fitur `%s` tidak dikenal. Fitur yang tersedia: %s.",
+	"enable.save_failed": "Gagal menyimpan pengaturan grup: %s",
+	"enable.on":          "aktif",
+	"enable.off":         "nonaktif",
+	"feature.game":       "Permainan seperti wordle dan sawit",
+	"feature.confess":    "Confess anonim ke grup ini",
+	"feature.suggest":    "Saran perintah terdekat saat salah ketik",
+	"feature.download":   "Pengunduh media",
+	"feature.stk":        "Pembuat stiker",
+	"feature.ta":         "Tag semua anggota",
+	"feature.vo":         "Pembuka pesan sekali lihat",
+
+	// Language
+	"lang.current":      "Bahasa saat ini: %s%s\nTersedia: %s",
+	"lang.default_mark": " (bawaan)",
+	"lang.invalid":      "Bahasa `%s` tidak dikenal. Tersedia: %s",
+	"lang.saved":        "Bahasa disimpan! Bahasa saat ini: %s",
+	"lang.save_failed":  "Gagal menyimpan bahasa: %s",
+	"lang.invalid_sub":  "Perintah lang tidak valid: %s\nGunakan `%shelp lang` untuk daftar subperintah.",
+
+	// SIX
+	"six.invalid":                  "Perintah SIX tidak valid: %s\nGunakan `%shelp six` untuk daftar perintah.",
+	"six.sender_failed":            "Gagal mengambil ID pengguna %q",
+	"six.class_not_found":          "Tidak dapat menemukan matkul %s kelas %02d. Jika ini merupakan kesalahan, coba hubungi pemilik bot.",
+	"six.internal_error":           "Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s",
+	"six.bad_code":                 "Kode kelas %q tidak valid: %s",
+	"six.follow.failed":            "Gagal menambahkan status mengikuti kelas: Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s",
+	"six.follow.already":           "Sudah pernah mengikuti %s-%02d (%s).",
+	"six.follow.success":           "Berhasil mengikuti %s-%02d (%s).",
+	"six.follow.report":            "Hasil mengikuti kelas:",
+	"six.follow.empty":             "Kamu belum mengikuti kelas apa pun. Gunakan `%ssix follow` _kode_kelas_ untuk mulai mengikuti.",
+	"six.follow.list_header":       "Kelas yang kamu ikuti (%d):",
+	"six.unfollow.report":          "Hasil berhenti mengikuti kelas:",
+	"six.unfollow.success":         "Berhenti mengikuti %s-%02d (%s).",
+	"six.unfollow.not_following":   "Tidak sedang mengikuti %s-%02d (%s).",
+	"six.unfollow.all":             "Berhenti mengikuti %d kelas.",
+	"six.reminder.bad_offset":      "Format offset salah: %s. Contoh yang benar: `+10`, `^-20`, `-30m`, `^`",
+	"six.reminder.offset_range":    "Nilai offset terlalu besar atau kecil. Paling kecil -1 pekan (-%d menit) dan paling besar 1 pekan (%d menit). Didapat: %d menit",
+	"six.reminder.class_failed":    "Gagal mengambil ID kelas: Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s",
+	"six.reminder.add_failed":      "Gagal menambahkan reminder kelas: Kesalahan internal, harap segera lapor pemilik bot.\nInfo tambahan: %s",
+	"six.reminder.describe":        "%d menit %s kelas %s-%02d (%s) %s",
+	"six.reminder.exists":          "Pengingat %q sudah pernah ditambahkan.",
+	"six.reminder.added":           "Berhasil menambahkan pengingat %q.",
+	"six.reminder.list_failed":     "Gagal mengambil data reminder, harap laporkan ke pemilik bot.\nInformasi tambahan: `%s`",
+	"six.reminder.empty":           "Tidak ada reminder yang diatur. Lihat `%s help reminder` untuk informasi lebih lanjut.",
+	"six.reminder.list_header":     "Daftar reminder:",
+	"six.reminder.list_item":       "%s kelas %s",
+	"six.reminder.days":            "%d hari ",
+	"six.reminder.hours":           "%d jam ",
+	"six.reminder.minutes":         "%d menit ",
+	"six.reminder.before":          "sebelum",
+	"six.reminder.after":           "setelah",
+	"six.reminder.at":              "saat",
+	"six.reminder.exactly_at":      "tepat saat",
+	"six.reminder.starts":          "dimulai",
+	"six.reminder.ends":            "berakhir",
+	"six.reminder.remove_usage":    "Gunakan `%ssix reminder remove` _kode_kelas_ [ _offset_ ] atau `%ssix reminder remove all`.",
+	"six.reminder.remove_none":     "Tidak ada pengingat yang cocok untuk dihapus.",
+	"six.reminder.removed":         "Berhasil menghapus %d pengingat kelas %s-%02d (%s).",
+	"six.reminder.removed_all":     "Berhasil menghapus %d pengingat.",
+	"six.reminder.pause_usage":     "Berikan batas jeda, contoh: `%ssix reminder pause 2026-03-01` atau `%ssix reminder pause 7d`.",
+	"six.reminder.bad_date":        "Batas jeda %q tidak valid. Gunakan tanggal YYYY-MM-DD atau DD-MM-YYYY, atau durasi seperti `7d`.",
+	"six.reminder.date_past":       "Batas jeda %s sudah lewat.",
+	"six.reminder.paused":          "Semua pengingat dijeda sampai %s.",
+	"six.reminder.muted":           "Semua pengingat dimatikan. Gunakan `%ssix reminder resume` untuk menyalakannya kembali.",
+	"six.reminder.resumed":         "Pengingat kembali aktif.",
+	"six.reminder.status_muted":    "_Semua pengingat sedang dimatikan, gunakan `%ssix reminder resume` untuk menyalakannya._",
+	"six.reminder.status_paused":   "_Semua pengingat dijeda sampai %s._",
+	"six.reminder.snoozed":         "Pengingat ditunda %d menit.",
+	"six.reminder.alert":           "Reminder: %s%s kelas %s-%02d (%s) %s",
+	"six.reminder.fetch_failed":    "SixReminder: Gagal mengambil data reminder: %s",
+	"six.reminder.delivery_failed": "SixReminder: Gagal menyimpan hasil delivery: %s",
+	"six.digest.status":            "Ringkasan jadwal: %s.\nGunakan `%ssix digest daily|weekly|both|off` untuk mengubahnya.",
+	"six.digest.saved":             "Ringkasan jadwal diatur: %s.",
+	"six.digest.invalid":           "Pilihan ringkasan %q tidak valid, gunakan daily, weekly, both, atau off.",
+	"six.digest.daily":             "harian setiap pagi",
+	"six.digest.weekly":            "mingguan setiap Minggu malam",
+	"six.digest.both":              "harian setiap pagi dan mingguan setiap Minggu malam",
+	"six.digest.off":               "tidak aktif",
+	"six.digest.today":             "Jadwal hari ini, %s:",
+	"six.digest.next_week":         "Jadwal pekan depan, %s sampai %s:",
+	"six.jadwal.bad_when":          "Pilihan %q tidak valid, gunakan today, week, atau tanggal seperti 2025-02-17.",
+	"six.jadwal.day":               "Jadwal %s:",
+	"six.jadwal.week":              "Jadwal %s sampai %s:",
+	"six.jadwal.empty_day":         "Tidak ada jadwal kelas yang kamu ikuti pada %s.",
+	"six.jadwal.empty_week":        "Tidak ada jadwal kelas yang kamu ikuti dari %s sampai %s.",
+	"six.cek.need_more":            "Berikan paling tidak dua kode kelas yang berbeda, contoh: `%ssix cek ET2202-01 MA1101-03`.",
+	"six.cek.none":                 "Tidak ada jadwal yang bentrok antara %s.",
+	"six.cek.found":                "Ditemukan %d pasang kelas yang jadwalnya bentrok:",
+	"six.cek.conflict":             "%s dan %s bentrok %d kali, pertama kali %s",
+	"six.cek.no_schedule":          "%s belum memiliki jadwal, jadi tidak dapat dicek.",
+
+	// Schedules
+	"schedules.updates_header":      "Ada perubahan pada kelas yang kamu ikuti:",
+	"schedules.class_removed":       "Kelas ini dihapus dari jadwal, kamu tidak lagi mengikutinya",
+	"schedules.subject_removed":     "Mata kuliah ini dihapus dari jadwal, kamu tidak lagi mengikuti kelasnya",
+	"schedules.number_changed":      "Nomor kelas berubah dari %02d menjadi %02d",
+	"schedules.quota_changed":       "Kuota berubah dari %s menjadi %s",
+	"schedules.quota_none":          "tidak ada",
+	"schedules.constraints_changed": "Batasan peserta kelas berubah",
+	"schedules.lecturers_added":     "Dosen baru: %s",
+	"schedules.lecturers_removed":   "Dosen yang tidak lagi mengajar: %s",
+	"schedules.teams_added":         "Link Teams baru: %s",
+	"schedules.teams_removed":       "Link Teams dihapus",
+	"schedules.edunex_added":        "Link Edunex baru: https://edunex.itb.ac.id/courses/%d",
+	"schedules.edunex_removed":      "Link Edunex dihapus",
+	"schedules.method_changed":      "%s %s sekarang %s",
+	"schedules.moved":               "%s dipindah dari %s ke %s",
+	"schedules.added":               "Jadwal baru: %s %s",
+	"schedules.removed":             "Jadwal dihapus: %s %s",
+	"schedules.in_rooms":            " di %s",
+	"schedules.more_moved":          "...dan %d jadwal lain juga dipindah",
+	"schedules.more_added":          "...dan %d jadwal baru lainnya",
+	"schedules.more_removed":        "...dan %d jadwal lain juga dihapus",
+	"schedules.rooms_added":         "ditambah %s",
+	"schedules.rooms_removed":       "dihapus %s",
+	"schedules.room_changed":        "Ruangan %s %s %s",
+	"schedules.room_changed_many":   "Ruangan %s di %d pertemuan mulai %s %s",
+	"schedules.activity.lecture":    "Kuliah",
+	"schedules.activity.tutorial":   "Tutorial",
+	"schedules.activity.lab_work":   "Praktikum",
+	"schedules.activity.quiz":       "Kuis",
+	"schedules.activity.midterm":    "UTS",
+	"schedules.activity.final":      "UAS",
+	"schedules.activity.other":      "Kegiatan",
+	"schedules.method.in_person":    "tatap muka",
+	"schedules.method.online":       "online",
+	"schedules.method.hybrid":       "hybrid",
+	"schedules.method.other":        "dengan metode lain",
+	"schedules.weekday.0":           "Minggu",
+	"schedules.weekday.1":           "Senin",
+	"schedules.weekday.2":           "Selasa",
+	"schedules.weekday.3":           "Rabu",
+	"schedules.weekday.4":           "Kamis",
+	"schedules.weekday.5":           "Jumat",
+	"schedules.weekday.6":           "Sabtu",
+	"schedules.month.1":             "Jan",
+	"schedules.month.2":             "Feb",
+	"schedules.month.3":             "Mar",
+	"schedules.month.4":             "Apr",
+	"schedules.month.5":             "Mei",
+	"schedules.month.6":             "Jun",
+	"schedules.month.7":             "Jul",
+	"schedules.month.8":             "Agu",
+	"schedules.month.9":             "Sep",
+	"schedules.month.10":            "Okt",
+	"schedules.month.11":            "Nov",
+	"schedules.month.12":            "Des",
+
+	// Sawit
+	"sawit.invalid":                "Perintah sawit tidak valid: %s",
+	"sawit.get_failed":             "Gagal mengambil sawit partisipan: %s",
+	"sawit.save_failed":            "Gagal menyimpan sawit partisipan: %s",
+	"sawit.rank_failed":            "Gagal mengambil peringkat sawit partisipan: %s",
+	"sawit.participant_failed":     "Gagal mengambil info partisipan: %s",
+	"sawit.list_failed":            "Gagal mengambil daftar sawit: %s",
+	"sawit.empty":                  "Belum ada yang menanam sawit di sini :(\nWowo tidak terkesan.",
+	"sawit.unknown_user":           "[Pengguna tidak dikenal: %s]",
+	"sawit.leaderboard.top":        "Daftar sawit tertinggi:\n\n",
+	"sawit.leaderboard.bottom":     "Daftar sawit terpendek:\n\n",
+	"sawit.leaderboard.legend":     "\n_[+] artinya penanam belum menumbuhkan sawitnya hari ini._",
+	"sawit.attack.too_big":         "Ukuran seranganmu lebih besar dari tinggi sawitmu (%d > %d)",
+	"sawit.attack.challenge":       "%s menantang chat ini dengan *%d* cm!\nBeri reaksi emoji apa saja untuk menerima tantangan.",
+	"sawit.attack.save_failed":     "Gagal menyimpan info serangan sawit: %s",
+	"sawit.grow.already":           "Kamu sudah menumbuhkan sawitmu hari ini.\nTunggu *%d jam %d menit*",
+	"sawit.grow.grown":             "Sawitmu tumbuh *%d cm* dan sekarang tingginya *%d cm*.\nPeringkatmu di klasemen: %d.\n\nBisa tumbuh lagi dalam *%d jam %d menit*",
+	"sawit.grow.shrunk":            "Sawitmu menyusut *%d cm* dan sekarang tingginya *%d cm*.\nPeringkatmu di klasemen: %d.\n\nBisa tumbuh lagi dalam *%d jam %d menit*",
+	"sawit.grow.too_deep":          "Bro, kok sawitmu dalam banget, untuk sekarang cuma bisa kukasih 100 cm. Tinggi sawitmu sekarang *%d cm*\nPeringkatmu di klasemen: %d.\n\nBisa tumbuh lagi dalam *%d jam %d menit*",
+	"sawit.grow.negative":          "Tinggi sawitmu negatif, ya? Kubuat jadi *0 cm* saja.\nPeringkatmu di klasemen: %d.\n\nBisa tumbuh lagi dalam *%d jam %d menit*",
+	"sawit.stat":                   "Tinggi: *%d*\nPeringkat di klasemen: *%d*\n\nRasio menang: *%.02f%%*\nSerangan: *%d*\nMenang: *%d*\nTinggi didapat: *%d cm*\nTinggi hilang: *%d cm*",
+	"sawit.transfer.invalid":       "Jumlah transfer tidak valid",
+	"sawit.transfer.too_big":       "Jumlah transfermu lebih besar dari tinggi sawitmu (%d > %d)",
+	"sawit.transfer.target_failed": "Gagal mengambil partisipan tujuan: %s",
+	"sawit.transfer.target_sawit":  "Gagal mengambil sawit partisipan tujuan: %s",
+	"sawit.transfer.success":       "Berhasil mentransfer *%d* cm dari %s ke %s!",
+	"sawit.duel.negative":          "Halo, %s, tinggi sawitmu negatif, bayar dulu utangmu bro 😭🙏",
+	"sawit.duel.empty":             "Halo, %s, kamu belum punya sawit sekarang",
+	"sawit.duel.placeholder":       "Ini pesan pengganti, kalau kamu melihat ini, mungkin pesan yang dibalas sudah terlalu lama.",
+	"sawit.duel.result":            "Pemenangnya adalah *%s*! Sawitnya sekarang setinggi *%d cm*. Sawit yang kalah setinggi *%d cm*\n\nPeringkat *%s* di klasemen: *%d*.\nPeringkat *%s* di klasemen: *%d*.\n\nRasio menang *pemenang — %.02f%%*\nRasio menang *yang kalah — %.02f%%*",
+
+	// Wordle
+	"wordle.get_failed":   "Gagal mengambil wordle pengguna: %s",
+	"wordle.set_failed":   "Gagal mengatur wordle pengguna: %s",
+	"wordle.save_failed":  "Gagal menyimpan tebakan wordle: %s",
+	"wordle.image_failed": "Gagal membuat gambar wordle: %s",
+	"wordle.done":         "Wordle hari ini sudah benar (%s), tunggu %s lagi",
+	"wordle.out":          "Kesempatanmu sudah habis, jawabannya %s",
+	"wordle.too_short":    "Kata terlalu pendek",
+	"wordle.not_exists":   "Kata %q tidak ada",
+	"wordle.first_try":    "Keren, langsung benar di tebakan pertama",
+	"wordle.last_try":     "Tebakanmu pas banget, untung benar",
+	"wordle.correct":      "Yeay, tebakanmu benar!",
+	"wordle.over":         "Tebakan sudah habis, jawabannya %s",
+	"wordle.wrong":        "Yah, tebakanmu salah, coba lagi (%d/6)",
+	"wordle.start":        "Kirim %swordle [TEBAKANMU] untuk menebak kata hari ini!",
+	"wordle.continue":     "Kirim %swordle [TEBAKANMU] untuk melanjutkan tebakanmu!",
+
+	// LINE stickers
+	"stkline.no_url":               "Berikan url toko stikernya",
+	"stkline.bad_url":              "Url yang diberikan tidak dapat dibaca",
+	"stkline.bad_scheme":           "Skema url yang diberikan bukan \"https\"",
+	"stkline.bad_host":             "Host url yang diberikan bukan \"store.line.me\"",
+	"stkline.store_home":           "Url yang diberikan adalah beranda toko",
+	"stkline.bad_shop":             "Jenis toko tidak didukung, pastikan url yang diberikan adalah stickershop atau emojishop. Didapat: %s",
+	"stkline.no_id":                "Tidak dapat mengambil id stiker/emoji, apakah url yang diberikan valid?",
+	"stkline.fetch_failed":         "Gagal mengambil halaman toko",
+	"stkline.read_failed":          "Gagal membaca halaman toko: %s",
+	"stkline.no_name":              "Gagal mengambil nama stiker/emoji",
+	"stkline.no_author":            "Gagal mengambil pembuat stiker/emoji",
+	"stkline.cover_count":          "Tidak dapat menemukan sampul stiker/emoji: seharusnya 1, didapat %d",
+	"stkline.cover_no_preview":     "Tidak dapat menemukan sampul stiker/emoji: atribut data-preview tidak ada",
+	"stkline.cover_invalid":        "Tidak dapat menemukan sampul stiker/emoji: data tidak valid",
+	"stkline.cover_fetch_failed":   "Tidak dapat menemukan sampul stiker/emoji: gagal mengambil gambar sampul: %s",
+	"stkline.cover_png_failed":     "Tidak dapat menemukan sampul stiker/emoji: gagal membaca gambar sampul sebagai png: %s",
+	"stkline.cover_webp_failed":    "Tidak dapat menemukan sampul stiker/emoji: gagal mengubah gambar sampul ke webp: %s",
+	"stkline.cover_zip_failed":     "Gagal menambahkan gambar sampul ke zip: %s",
+	"stkline.found":                "Nama stiker: %q\nStiker ditemukan: %d\n\nMohon tunggu...",
+	"stkline.item_missing":         "Gagal mengambil data stiker/emoji pada indeks %d",
+	"stkline.item_parse_failed":    "Gagal membaca data stiker/emoji pada indeks %d: %s",
+	"stkline.item_no_url":          "Url stiker/emoji pada indeks %d kosong",
+	"stkline.item_download_failed": "Gagal mengunduh stiker/emoji pada indeks %d",
+	"stkline.item_download_error":  "Gagal mengunduh stiker/emoji pada indeks %d: %s",
+	"stkline.apng_failed":          "Gagal membaca stiker/emoji sebagai file PNG animasi: %s",
+	"stkline.png_header_failed":    "Gagal mengambil header PNG: %s",
+	"stkline.webp_encode_failed":   "Gagal mengubah ke webp: %s",
+	"stkline.webp_modify_failed":   "Gagal mengubah webp: %s",
+	"stkline.webp_build_failed":    "Gagal menyusun webp setelah diubah: %s",
+	"stkline.failed":               "Gagal: %s",
+	"stkline.metadata_failed":      "Gagal menambahkan metadata: %s",
+	"stkline.sticker_failed":       "Gagal membuat stiker: %s",
+	"stkline.zip_failed":           "Gagal menambahkan stiker/emoji ke zip: %s",
+	"stkline.upload_failed":        "Gagal mengunggah paket stiker ke server: %s",
+
+	// Admin
+	"admin.invalid":                "Perintah admin tidak valid: %s\nGunakan `%shelp admin` untuk daftar subperintah.",
+	"admin.summary":                "Mode khusus pemilik: %t\nSubperintah: %s",
+	"admin.reload_failed":          "Gagal memuat ulang konfigurasi, konfigurasi lama tetap dipakai: %s",
+	"admin.reloaded":               "Konfigurasi dimuat ulang.\nPemilik: %s\nMode khusus pemilik: %t",
+	"admin.owneronly.current":      "Mode khusus pemilik: %t",
+	"admin.invalid_state":          "Status `%s` tidak valid, gunakan `on` atau `off`.",
+	"admin.owneronly.set":          "Mode khusus pemilik: %t\nBerlaku sampai konfigurasi dimuat ulang atau bot dimulai ulang.",
+	"admin.groups.failed":          "Gagal mengambil daftar grup: %s",
+	"admin.groups.empty":           "Bot tidak berada di grup mana pun.",
+	"admin.leave.bad_number":       "Nomor grup harus antara 1 sampai %d.",
+	"admin.leave.bad_group":        "Grup `%s` tidak valid, berikan nomornya dari `admin groups` atau JID-nya.",
+	"admin.leave.failed":           "Gagal keluar dari %s: %s",
+	"admin.leave.done":             "Keluar dari %s.",
+	"admin.broadcast.empty":        "Berikan pesan yang ingin disiarkan.",
+	"admin.broadcast.failed":       "Gagal mengambil daftar pengikut: %s",
+	"admin.broadcast.no_followers": "Belum ada yang mengikuti kelas.",
+	"admin.broadcast.start":        "Menyiarkan ke %d pengikut, butuh sekitar %s.",
+	"admin.broadcast.done":         "Siaran terkirim ke %d dari %d pengikut.",
+	"admin.broadcast.stopped":      "Siaran berhenti setelah %d dari %d pengikut: %s",
+	"admin.block.bad_target":       "Target `%s` tidak valid: %s",
+	"admin.block.refused":          "Tidak bisa memblokir pemilik atau bot itu sendiri.",
+	"admin.block.bad_duration":     "Durasi harus positif.",
+	"admin.block.failed":           "Gagal memblokir %s: %s",
+	"admin.block.done":             "%s diblokir %s, bot sekarang mengabaikannya di mana pun.",
+	"admin.unblock.failed":         "Gagal membuka blokir %s: %s",
+	"admin.unblock.not_blocked":    "%s tidak diblokir.",
+	"admin.unblock.done":           "Blokir %s dibuka.",
+	"admin.blocks.failed":          "Gagal mengambil daftar blokir: %s",
+	"admin.blocks.empty":           "Daftar blokir kosong.",
+	"admin.groups.header":          "*Grup yang diikuti (%d)*\n",
+	"admin.groups.entry":           "%d. %s\n   %s, %d anggota",
+	"admin.status.queue":           "*Antrean*",
+	"admin.status.pool":            "Worker: %d (%d berjalan)\nDalam antrean: %d/%d\nDitolak: %d",
+	"admin.status.not_started":     "Belum dimulai",
+	"admin.status.cron":            "*Cron job*",
+	"admin.status.no_cron":         "Tidak ada",
+	"admin.status.never":           "belum pernah",
+	"admin.status.job":             "- *%s* `%s`\n  Berikutnya: %s\n  Terakhir: %s (%s), %d kali",
+	"admin.status.running":         ", sedang berjalan",
+	"admin.loglevel.default":       "Level log bawaan: *%s*",
+	"admin.loglevel.temporary":     "Berlaku sampai konfigurasi dimuat ulang atau bot dimulai ulang.",
+	"admin.blocks.header":          "*Daftar blokir (%d)*\n",
+	"admin.blocks.no_reason":       "tanpa alasan",
+	"admin.block.forever":          "selamanya",
+	"admin.block.until":            "sampai %s",
+
+	// Confess
+	"confess.no_group":           "Kamu tidak bergabung di grup mana pun atau grupmu tidak mengizinkan confess.",
+	"confess.many_groups":        "Kamu terlihat ada di beberapa grup, atur dulu tujuannya dengan `.confesstarget`",
+	"confess.empty":              "Berikan pesan confess-nya.",
+	"confess.target.save_failed": "Gagal menyimpan info kontak: %s",
+	"confess.target.saved":       "Berhasil mengatur tujuan confess ke %d - %q",
+	"confess.target.usage":       "Gunakan `.confesstarget group_id` untuk mengatur tujuan confess.",
+	"confess.target.current":     "Pilihanmu saat ini: %d - %q",
+	"confess.target.available":   "Grup yang tersedia:",
+
+	// Game
+	"game.current":  "Apakah game diizinkan di grup ini? %t",
+	"game.invalid":  "Input tidak valid.\nGunakan %s untuk mengaktifkan.\nGunakan %s untuk menonaktifkan.",
+	"game.both":     "Kesalahan internal: isEnabled dan isDisabled sama-sama true.",
+	"game.enabled":  "Mulai sekarang game diaktifkan di grup ini.",
+	"game.disabled": "Mulai sekarang game dinonaktifkan di grup ini.",
+
+	// Download
+	"download.no_url":        "Berikan url-nya (saat ini mendukung: instagram, tiktok, youtube[jangan deh])",
+	"download.no_youtube":    "Dukungan Youtube saat ini dihentikan",
+	"download.upload_failed": "gagal mengunggah media: %s",
+
+	// Sticker
+	"stk.not_media":        "Pesan yang diberikan bukan media. Jangan diulangi lagi.",
+	"stk.view_once":        "Media yang diberikan adalah sekali lihat. Jangan diulangi lagi.",
+	"stk.not_downloadable": "Media yang diberikan tidak dapat diunduh, silakan kirim ulang.\nInfo debug: %s",
+	"stk.download_failed":  "Tidak dapat mengunduh media. Silakan kirim ulang.\nInfo debug: %s",
+	"stk.convert_failed":   "Gagal mengubah media menjadi stiker. Ini kesalahan internal, coba lagi nanti.\nInfo debug: %s",
+
+	// View once
+	"vo.no_reply":          "Balas pesan sekali lihat.",
+	"vo.query_failed":      "Gagal mengambil data dari tabel vo_request: %s",
+	"vo.not_view_once":     "Pesan yang dibalas bukan pesan sekali lihat.",
+	"vo.missing_fields":    "Pesan sekali lihat yang dibalas tidak lengkap. Kemungkinan besar kamu memakai WhatsApp versi terbaru.\nInfo debug: %s",
+	"vo.save_failed":       "Gagal menyimpan ke tabel vo_request: %s",
+	"vo.already_requested": "Pesan sekali lihat ini sudah diminta oleh @%s",
+	"vo.already_accepted":  "Pesan sekali lihat ini sudah diminta oleh @%s dan sudah disetujui pengirimnya",
+	"vo.already_denied":    "Pesan sekali lihat ini sudah diminta oleh @%s dan sudah ditolak pengirimnya",
+	"vo.waiting_approval":  "Menunggu persetujuan dari @%s (beri reaksi ✅ untuk menyetujui, ❌ atau biarkan saja untuk menolak)",
+	"vo.denied":            "Permintaan ditolak.",
+	"vo.placeholder":       "Pesan pengganti. Kalau kamu melihat ini, mungkin aplikasimu sedang bermasalah.",
+
+	// Redirect
+	"redirect.failed": "Permintaan gagal\nDebug: %s",
+
+	// PDDikti
+	"pddikti.no_key":    "Perintah ini belum diatur oleh pemilik bot.\nDebug: PDDIKTI_KEY atau PDDIKTI_IV tidak ada",
+	"pddikti.not_found": "Tidak ditemukan",
+
+	// IGRS
+	"igrs.request_failed": "Kesalahan internal saat membuat permintaan",
+	"igrs.slow":           "Sepertinya permintaan ini butuh waktu lebih lama dari biasanya, mohon tunggu",
+	"igrs.fetch_failed":   "Permintaan gagal: %v",
+	"igrs.api_status":     "API mengembalikan %s",
+	"igrs.decode_failed":  "Kesalahan internal saat membaca respons: %s",
+	"igrs.no_name":        "Berikan nama game-nya",
+	"igrs.not_found":      "Game tidak ditemukan",
+	"igrs.no_id":          "Berikan id game-nya (bilangan positif)",
+	"igrs.bad_id":         "ID yang diberikan bukan angka",
+
+	// Resolve subject
+	"resolve.usage":           "Berikan argumennya (contoh: .resolve-subject ET1201=12345 ET1202=23455)",
+	"resolve.failed":          "Terjadi kesalahan: %s",
+	"resolve.bad_value_count": "Jumlah nilai seharusnya 1, didapat %d",
+	"resolve.bad_id":          "Tidak dapat membaca %q sebagai uint: %s",
+	"resolve.bad_code_length": "Panjang kode seharusnya 6, didapat %d",
+	"resolve.bad_code":        "Kode %s tidak valid",
+	"resolve.id_taken":        "ID matkul %d sudah dipakai oleh %s, periksa lagi ID untuk %s",
+
+	// Refresh groups
+	"refresh.groups_failed":       "Gagal mengambil daftar grup: %s",
+	"refresh.participants_failed": "Gagal mengambil daftar peserta grup %s: %s",
+	"refresh.info_failed":         "Gagal mengambil info grup %s: %s",
+	"refresh.contact_failed":      "Gagal mengambil/membuat info kontak %s: %s",
+	"refresh.save_failed":         "Gagal menyimpan peserta dengan kontak %s di grup %s: %s",
+	"refresh.update_failed":       "Gagal memperbarui peserta dengan kontak %s di grup %s: %s",
+
+	// Stats
+	"stats.bad_window": "Rentang waktu harus positif.",
+	"stats.failed":     "Gagal mengambil statistik perintah: %s",
+	"stats.empty":      "Tidak ada perintah yang dijalankan dalam %s terakhir.",
+	"stats.header":     "*Statistik perintah, %s terakhir*\n",
+	"stats.entry":      "%d. *%s*: %dx, %.1f%% galat, p95 %s",
+
+	// NIM
+	"nim.query_failed": "Gagal mengambil data dari database: %s\nWaktu query: %d ms",
+	"nim.found":        "Ditemukan %d mahasiswa\nWaktu query: %d ms",
+	"nim.entry":        "Nama: %s\nNIM: %d\nJurusan - Fakultas: %s - %s",
+
+	// Prefix
+	"prefix.invalid":       "Perintah prefix tidak valid: %s\nGunakan `%shelp prefix` untuk daftar subperintah.",
+	"prefix.load_failed":   "Gagal memuat pengaturan prefix: %s",
+	"prefix.bad_prefix":    "Prefix `%s` tidak valid: %s.",
+	"prefix.too_many":      "Terlalu banyak prefix, paling banyak %d.",
+	"prefix.invalid_state": "Status `%s` tidak valid, gunakan `on` atau `off`.",
+	"prefix.save_failed":   "Gagal menyimpan pengaturan prefix: %s",
+	"prefix.saved":         "Pengaturan prefix disimpan! %s",
+	"prefix.current":       "Prefix saat ini: %s",
+	"prefix.mention":       "Dipanggil lewat mention: %t",
+	"prefix.bad_length":    "panjangnya harus 1 sampai %d karakter",
+	"prefix.bad_alnum":     "huruf, angka, dan spasi tidak diperbolehkan",
+	"prefix.bad_symbol":    "tanda kutip, `=`, dan `@` tidak diperbolehkan",
+}
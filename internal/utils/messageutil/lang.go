@@ -0,0 +1,34 @@
+package messageutil
+
+import (
+	"kano/internal/utils/i18n"
+
+	"go.mau.fi/whatsmeow"
+)
+
+// The group language wins in groups so everyone there reads the same one,
+// otherwise the sender's own preference is used
+func (c *MessageContext) Lang() i18n.Lang {
+	if c.Group != nil && c.Group.GroupSettings != nil && c.Group.GroupSettings.Language != "" {
+		return i18n.Lang(c.Group.GroupSettings.Language)
+	}
+	if c.Contact != nil && c.Contact.Language != "" {
+		return i18n.Lang(c.Contact.Language)
+	}
+	return i18n.Default
+}
+
+// Resolves the message in the chat language
+func (c *MessageContext) T(msgId i18n.MessageID, args ...any) string {
+	return i18n.T(c.Lang(), msgId, args...)
+}
+
+// Same as QuoteReply, but the text comes from the message catalog
+func (c *MessageContext) QuoteReplyT(msgId i18n.MessageID, args ...any) (whatsmeow.SendResponse, error) {
+	return c.Reply(c.T(msgId, args...), ReplyConfig{Quoted: true})
+}
+
+// Same as Reply, but the text comes from the message catalog
+func (c *MessageContext) ReplyT(msgId i18n.MessageID, args ...any) (whatsmeow.SendResponse, error) {
+	return c.Reply(c.T(msgId, args...))
+}
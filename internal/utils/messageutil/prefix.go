@@ -0,0 +1,47 @@
+package messageutil
+
+import (
+	"kano/internal/config"
+	"kano/internal/utils/chatutil/contactutil"
+	"kano/internal/utils/chatutil/grouputil"
+
+	"go.mau.fi/whatsmeow"
+)
+
+// Picks the prefixes of the chat: the group settings in groups, the contact
+// settings in private chats, falling back to the default ones. When the
+// mention trigger is on, mentioning the bot works as a prefix too.
+func chatPrefixes(cli *whatsmeow.Client, contact *contactutil.Contact, group *grouputil.Group) []string {
+	prefixes := config.GetParser().Prefixes()
+	mention := false
+
+	if group != nil && group.GroupSettings != nil {
+		if len(group.GroupSettings.Prefixes) > 0 {
+			prefixes = group.GroupSettings.Prefixes
+		}
+		mention = group.GroupSettings.IsMentionTriggerAllowed
+	} else if contact != nil {
+		if len(contact.Prefixes) > 0 {
+			prefixes = contact.Prefixes
+		}
+		mention = contact.IsMentionTriggerAllowed
+	}
+
+	if mention {
+		prefixes = append(MentionPrefixes(cli), prefixes...)
+	}
+	return prefixes
+}
+
+// Mentions are written as @ followed by the user part of the JID, the
+// trailing space keeps @123 from matching @1234
+func MentionPrefixes(cli *whatsmeow.Client) []string {
+	var res []string
+	if cli.Store.ID != nil {
+		res = append(res, "@"+cli.Store.ID.User+" ")
+	}
+	if !cli.Store.LID.IsEmpty() {
+		res = append(res, "@"+cli.Store.LID.User+" ")
+	}
+	return res
+}
@@ -0,0 +1,36 @@
+package messageutil
+
+import (
+	"go.mau.fi/whatsmeow"
+	"go.mau.fi/whatsmeow/proto/waCommon"
+	"go.mau.fi/whatsmeow/proto/waE2E"
+	"go.mau.fi/whatsmeow/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// Builds a reaction to the message described by info. Used where there is no
+// MessageContext yet, otherwise use React.
+func BuildReaction(info types.MessageInfo, emoji string) *waE2E.Message {
+	msg := &waE2E.Message{
+		ReactionMessage: &waE2E.ReactionMessage{
+			Key: &waCommon.MessageKey{
+				RemoteJID: proto.String(info.Chat.String()),
+				FromMe:    proto.Bool(info.IsFromMe),
+				ID:        proto.String(info.ID),
+			},
+			Text: proto.String(emoji),
+		},
+	}
+	if info.IsGroup {
+		msg.ReactionMessage.Key.Participant = proto.String(info.Sender.ToNonAD().String())
+	}
+	return msg
+}
+
+func (c *MessageContext) React(emoji string) (whatsmeow.SendResponse, error) {
+	msg := BuildReaction(c.Info, emoji)
+	if c.Group != nil {
+		msg.ReactionMessage.Key.Participant = proto.String(c.GetSender().String())
+	}
+	return c.SendMessage(msg)
+}
@@ -1,8 +1,10 @@
 package messageutil
 
 import (
+	"context"
 	"kano/internal/config"
 	"kano/internal/logger"
+	"kano/internal/utils/argutil"
 	"kano/internal/utils/chatutil/contactutil"
 	"kano/internal/utils/chatutil/grouputil"
 	"kano/internal/utils/client"
@@ -21,12 +23,16 @@ type MessageContextCache struct {
 }
 
 type MessageContext struct {
+	// Cancelled when the handling deadline passes, see Context
+	ctx context.Context
 	// Whole message event
 	Event *events.Message
 	// Client context
 	Client *client.ClientContext
 	// Text parser, so you wouldn't call config.GetParser()
 	Parser parser.ParseResult
+	// Arguments validated against the command schema, empty if it has none
+	Args argutil.Values
 	// Logger, so you wouldn't call config.GetLogger()
 	Logger *logger.Logger
 	// Contact (database) info
@@ -44,37 +50,23 @@ type MessageContext struct {
 	cache MessageContextCache
 }
 
-func CreateContext(cli *whatsmeow.Client, ev *events.Message) *MessageContext {
+func CreateContext(evCtx context.Context, cli *whatsmeow.Client, ev *events.Message) *MessageContext {
 	ctx := MessageContext{
+		ctx:    evCtx,
 		Event:  ev,
-		Client: client.CreateContext(cli),
-		Logger: config.GetLogger().Sub("Message"),
+		Client: client.CreateContext(evCtx, cli),
+		Logger: config.GetLogger().Sub("Message").WithChat(ev.Info.Chat.String()).WithMessageID(ev.Info.ID),
 
 		Message:    ev.Message,
 		RawMessage: ev.RawMessage,
 		Info:       ev.Info,
 	}
 
-	var err error
-	parser := config.GetParser()
-	ctx.Parser, err = parser.Parse(ctx.GetText())
-	if err != nil {
-		ctx.Logger.Errorf("Failed to parse given text: %s", err)
-		if config.GetConfig().OwnerOnlyMode {
-			if !ctx.IsSenderSame(config.GetConfig().OwnerJID) {
-				ctx.QuoteReply("parser: %s", err)
-				return nil
-			}
-		}
-
-		return nil
-	}
-
 	sender := ctx.GetSender()
 	if sender.Server != types.HiddenUserServer {
 		ctx.Logger.Errorf("Sender JID server is not @lid and not @s.whatsapp.net")
 	} else {
-		contact, err := contactutil.Init(sender, ctx.Info.PushName)
+		contact, err := contactutil.Init(evCtx, sender, ctx.Info.PushName)
 		if err != nil {
 			ctx.Logger.Errorf("Failed to init contact object: %s", err)
 			return nil
@@ -84,7 +76,7 @@ func CreateContext(cli *whatsmeow.Client, ev *events.Message) *MessageContext {
 
 	chat := ctx.GetChat()
 	if chat.Server == types.GroupServer {
-		group, err := grouputil.Init(cli, chat)
+		group, err := grouputil.Init(evCtx, cli, chat)
 		if err != nil {
 			ctx.Logger.Errorf("Failed to init group object: %s", err)
 			// return nil
@@ -93,5 +85,35 @@ func CreateContext(cli *whatsmeow.Client, ev *events.Message) *MessageContext {
 		}
 	}
 
+	parser := config.GetParser().WithPrefixes(chatPrefixes(cli, ctx.Contact, ctx.Group))
+	var err error
+	ctx.Parser, err = parser.Parse(ctx.GetText())
+	if err != nil {
+		ctx.Logger.Errorf("Failed to parse given text: %s", err)
+		if config.GetConfig().OwnerOnlyMode {
+			if !ctx.IsSenderSame(config.GetConfig().OwnerJID) {
+				ctx.QuoteReply("parser: %s", err)
+				return nil
+			}
+		}
+
+		return nil
+	}
+
 	return &ctx
 }
+
+// Context of the event being handled. Pass it to database, HTTP and
+// WhatsApp calls so they stop once the handler runs out of time.
+func (c *MessageContext) Context() context.Context {
+	return c.ctx
+}
+
+// Copy of the context whose calls use ctx instead, for work that keeps going
+// after the handler returns
+func (c *MessageContext) WithContext(ctx context.Context) *MessageContext {
+	cp := *c
+	cp.ctx = ctx
+	cp.Client = client.CreateContext(ctx, c.Client.GetClient())
+	return &cp
+}
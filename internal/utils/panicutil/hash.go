@@ -0,0 +1,30 @@
+package panicutil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+)
+
+// Hash identifies where a panic came from, call it from the deferred function
+// that recovered r. Only the panic value's type and the function names with
+// their file:line go in, so argument values and pointers in the stack don't
+// make every occurrence of the same panic look different.
+func Hash(r any) string {
+	pcs := make([]uintptr, 64)
+	// Skip runtime.Callers and Hash itself
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%T\n", r)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(h, "%s %s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)[:6])
+}
@@ -0,0 +1,38 @@
+package panicutil
+
+import (
+	"errors"
+	"testing"
+)
+
+func boom(v any) {
+	panic(v)
+}
+
+func recoverHash(fn func()) (hash string) {
+	defer func() {
+		hash = Hash(recover())
+	}()
+	fn()
+	return
+}
+
+func TestHash(t *testing.T) {
+	site := func(v any) string {
+		return recoverHash(func() { boom(v) })
+	}
+
+	// Different pointers in the arguments must not change the hash
+	first, second := site(&struct{ n int }{1}), site(&struct{ n int }{2})
+	if first != second {
+		t.Errorf("same panic site should share the hash, got %s and %s", first, second)
+	}
+
+	if other := recoverHash(func() { boom(&struct{ n int }{1}) }); other == first {
+		t.Errorf("a different panic site should get a different hash")
+	}
+
+	if other := site(errors.New("boom")); other == first {
+		t.Errorf("a different panic type should get a different hash")
+	}
+}
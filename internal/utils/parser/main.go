@@ -15,6 +15,16 @@ func Init(prefixes []string) *Parser {
 	return &Parser{prefixes: prefixes}
 }
 
+func (p Parser) Prefixes() []string {
+	return p.prefixes
+}
+
+// Returns a copy of the parser that uses the given prefixes instead, e.g.
+// the prefixes configured for a chat
+func (p Parser) WithPrefixes(prefixes []string) *Parser {
+	return &Parser{prefixes: prefixes}
+}
+
 func (p *ParseResult) parseCommand(prefixes []string) {
 	if p == nil {
 		return
@@ -1,14 +1,15 @@
 package pddikti
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
 
-func GetMHSDetails(mhsID string) (*DiddyDetailsMHS, error) {
+func GetMHSDetails(ctx context.Context, mhsID string) (*DiddyDetailsMHS, error) {
 	url := buildUrl("detail", "mhs", mhsID)
 	fmt.Println("Fetching:", url)
-	resp, err := fetch(url)
+	resp, err := fetch(ctx, url)
 	if err != nil {
 		return nil, err
 	}
@@ -23,13 +24,13 @@ func GetMHSDetails(mhsID string) (*DiddyDetailsMHS, error) {
 	return &result, nil
 }
 
-func GetPNSDetails(pnsID string) (*DiddyDetailsPNS, error) {
+func GetPNSDetails(ctx context.Context, pnsID string) (*DiddyDetailsPNS, error) {
 	detail := DiddyDetailsPNS{}
 	detail.TeachingHistories = map[string][]DiddyPNSTeachHistory{}
 
 	// PNS Detail
 	profileUrl := buildUrl("dosen", "profile", pnsID)
-	resp, err := fetch(profileUrl)
+	resp, err := fetch(ctx, profileUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +42,7 @@ func GetPNSDetails(pnsID string) (*DiddyDetailsPNS, error) {
 
 	// Study Histories
 	sHistoryUrl := buildUrl("dosen", "study-history", pnsID)
-	resp, err = fetch(sHistoryUrl)
+	resp, err = fetch(ctx, sHistoryUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +55,7 @@ func GetPNSDetails(pnsID string) (*DiddyDetailsPNS, error) {
 	// Teaching Histories
 	var tHistories []DiddyPNSTeachHistory
 	tHistoryUrl := buildUrl("dosen", "teaching-history", pnsID)
-	resp, err = fetch(tHistoryUrl)
+	resp, err = fetch(ctx, tHistoryUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +71,7 @@ func GetPNSDetails(pnsID string) (*DiddyDetailsPNS, error) {
 	portfolios := []string{"penelitian", "pengabdian", "karya", "paten"}
 	for _, portfolioType := range portfolios {
 		portfolioUrl := buildUrl("dosen", "portofolio", portfolioType, pnsID)
-		resp, err = fetch(portfolioUrl)
+		resp, err = fetch(ctx, portfolioUrl)
 		if err != nil {
 			return nil, err
 		}
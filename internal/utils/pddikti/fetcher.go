@@ -1,6 +1,7 @@
 package pddikti
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/base64"
@@ -8,11 +9,22 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"kano/internal/utils/httpclient"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
+var client = httpclient.New(httpclient.Options{
+	Timeout:      30 * time.Second,
+	MaxRetries:   3,
+	BaseDelay:    time.Second,
+	MaxDelay:     15 * time.Second,
+	MaxBodyBytes: 8 << 20,
+	PerHost:      4,
+})
+
 var ErrNoKeyOrIv = errors.New("no specified key or iv")
 
 var BASE_URL string = string([]byte{104, 116, 116, 112, 115, 58, 47, 47, 97, 112, 105, 45, 112, 100, 100, 105, 107, 116, 105, 46, 107, 101, 109, 100, 105, 107, 116, 105, 115, 97, 105, 110, 116, 101, 107, 46, 103, 111, 46, 105, 100, 47, 112, 101, 110, 99, 97, 114, 105, 97, 110, 47, 97, 108, 108, 47})
@@ -74,11 +86,10 @@ func buildUrl(path ...string) string {
 	return BASE_URL + strings.Join(escaped, "/")
 }
 
-func fetch(url string) (*http.Response, error) {
+func fetch(ctx context.Context, url string) (*http.Response, error) {
 	origUrl := BASE_URL[:8] + BASE_URL[12:41]
 
-	client := http.Client{}
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -89,9 +100,9 @@ func fetch(url string) (*http.Response, error) {
 	return client.Do(req)
 }
 
-func Search(query string) (*DiddySearchResult, error) {
+func Search(ctx context.Context, query string) (*DiddySearchResult, error) {
 	url := BASE_URL + url.PathEscape(query)
-	resp, err := fetch(url)
+	resp, err := fetch(ctx, url)
 	if err != nil {
 		return nil, err
 	}
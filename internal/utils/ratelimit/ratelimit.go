@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Allow at most Limit hits inside a sliding Window. A zero rule allows everything.
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+func (r Rule) IsZero() bool {
+	return r.Limit <= 0 || r.Window <= 0
+}
+
+type bucket struct {
+	hits     []time.Time
+	notified bool
+	expire   time.Time
+}
+
+type Limiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+const sweepInterval = time.Minute
+
+func New() *Limiter {
+	return &Limiter{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// Take records a hit for the given key. If the rule is exceeded, the hit is
+// not recorded and wait is how long until the next hit is allowed. notified
+// tells whether an earlier rejected hit in the same streak already returned
+// notified=false, so the caller only has to warn the user once.
+func (l *Limiter) Take(key string, rule Rule) (wait time.Duration, notified bool) {
+	return l.TakeAll(Hit{key, rule})
+}
+
+type Hit struct {
+	Key  string
+	Rule Rule
+}
+
+// TakeAll is Take for several keys at once. The hits are only recorded when
+// every rule allows them, otherwise the first exceeded one is reported and
+// none of the keys lose a hit.
+func (l *Limiter) TakeAll(hits ...Hit) (wait time.Duration, notified bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > sweepInterval {
+		l.sweep(now)
+	}
+
+	buckets := make([]*bucket, len(hits))
+	for i, hit := range hits {
+		if hit.Rule.IsZero() {
+			continue
+		}
+
+		b, ok := l.buckets[hit.Key]
+		if !ok {
+			b = &bucket{}
+			l.buckets[hit.Key] = b
+		}
+		buckets[i] = b
+
+		// Drop hits that already left the window
+		cut := 0
+		for cut < len(b.hits) && now.Sub(b.hits[cut]) >= hit.Rule.Window {
+			cut++
+		}
+		b.hits = b.hits[cut:]
+
+		if len(b.hits) >= hit.Rule.Limit {
+			wait = hit.Rule.Window - now.Sub(b.hits[0])
+			notified = b.notified
+			b.notified = true
+			return wait, notified
+		}
+	}
+
+	for i, b := range buckets {
+		if b == nil {
+			continue
+		}
+		b.hits = append(b.hits, now)
+		b.notified = false
+		b.expire = now.Add(hits[i].Rule.Window)
+	}
+	return 0, false
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if now.After(b.expire) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTake(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := New()
+	l.now = func() time.Time { return now }
+
+	rule := Rule{Limit: 2, Window: 10 * time.Second}
+
+	for i := range 2 {
+		if wait, _ := l.Take("a", rule); wait != 0 {
+			t.Fatalf("hit %d should be allowed, got wait %s", i, wait)
+		}
+		now = now.Add(time.Second)
+	}
+
+	wait, notified := l.Take("a", rule)
+	if wait != 8*time.Second {
+		t.Errorf("expected wait 8s, got %s", wait)
+	}
+	if notified {
+		t.Errorf("first rejected hit should not be marked as notified")
+	}
+
+	if _, notified = l.Take("a", rule); !notified {
+		t.Errorf("second rejected hit should be marked as notified")
+	}
+
+	if wait, _ := l.Take("b", rule); wait != 0 {
+		t.Errorf("other keys should not be limited, got wait %s", wait)
+	}
+
+	now = now.Add(8 * time.Second)
+	if wait, notified := l.Take("a", rule); wait != 0 || notified {
+		t.Errorf("hit after the window should be allowed, got wait %s notified %t", wait, notified)
+	}
+
+	if wait, _ := l.Take("a", Rule{}); wait != 0 {
+		t.Errorf("zero rule should allow everything, got wait %s", wait)
+	}
+}
+
+func TestTakeAll(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := New()
+	l.now = func() time.Time { return now }
+
+	user := Rule{Limit: 2, Window: 10 * time.Second}
+	group := Rule{Limit: 1, Window: 10 * time.Second}
+
+	if wait, _ := l.TakeAll(Hit{"user", user}, Hit{"group", group}); wait != 0 {
+		t.Fatalf("first hit should be allowed, got wait %s", wait)
+	}
+	if wait, _ := l.TakeAll(Hit{"user", user}, Hit{"group", group}); wait != 10*time.Second {
+		t.Fatalf("group limit should reject with wait 10s, got %s", wait)
+	}
+
+	// The rejected hit above must not have used the user's second hit
+	if wait, _ := l.Take("user", user); wait != 0 {
+		t.Errorf("user should still have a hit left, got wait %s", wait)
+	}
+	if wait, _ := l.Take("user", user); wait == 0 {
+		t.Errorf("user should be limited after two recorded hits")
+	}
+}
@@ -2,9 +2,11 @@ package fetcher
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"kano/internal/config"
+	"kano/internal/utils/httpclient"
 	"net/http"
 	"net/url"
 	"time"
@@ -16,6 +18,16 @@ const BASE_URL = "https://six.itb.ac.id"
 
 var appContext = ""
 
+// SIX rate limits hard, so go slow and wait long on 429
+var client = httpclient.New(httpclient.Options{
+	Timeout:      time.Minute,
+	MaxRetries:   5,
+	BaseDelay:    5 * time.Second,
+	MaxDelay:     time.Minute,
+	MaxBodyBytes: 32 << 20,
+	PerHost:      2,
+})
+
 func BuildUrl(path []string, queries map[string][]string) *url.URL {
 	u, _ := url.Parse(BASE_URL)
 	u = u.JoinPath(path...)
@@ -32,7 +44,10 @@ func BuildUrl(path []string, queries map[string][]string) *url.URL {
 }
 
 func processFetch(resp *http.Response) (*url.URL, *goquery.Selection, error) {
-	p, _ := io.ReadAll(resp.Body)
+	p, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.Request.URL, nil, err
+	}
 
 	cookies := resp.Cookies()
 	for _, c := range cookies {
@@ -43,10 +58,6 @@ func processFetch(resp *http.Response) (*url.URL, *goquery.Selection, error) {
 	}
 
 	loc := resp.Request.URL
-	if resp.StatusCode > 299 {
-		return loc, nil, fmt.Errorf("server responded with status: %s", resp.Status)
-	}
-
 	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(p))
 	if err != nil {
 		return loc, nil, err
@@ -68,48 +79,43 @@ func processFetch(resp *http.Response) (*url.URL, *goquery.Selection, error) {
 	return loc, doc.Selection, err
 }
 
-func GetPage(paths []string, queries map[string][]string) (*url.URL, *goquery.Selection, error) {
-	retries := 5
-	sleepTime := 5 * time.Second
+func GetPage(ctx context.Context, paths []string, queries map[string][]string) (*url.URL, *goquery.Selection, error) {
 	cookie := ReadCookie()
 
-	for retries > 0 {
-		client := http.Client{}
-		req, err := http.NewRequest("GET", BuildUrl(paths, queries).String(), nil)
-		if err != nil {
-			return nil, nil, err
-		}
-		req.AddCookie(&http.Cookie{Name: "khongguan", Value: cookie.Get()})
-		req.Header.Set("User-Agent", config.USER_AGENT)
-
-		resp, err := client.Do(req)
-		if err != nil {
-			return nil, nil, err
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode == 429 {
-			time.Sleep(sleepTime)
-			retries--
-			continue
-		}
+	req, err := http.NewRequestWithContext(ctx, "GET", BuildUrl(paths, queries).String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "khongguan", Value: cookie.Get()})
+	req.Header.Set("User-Agent", config.GetConfig().UserAgent)
 
-		loc, sel, err := processFetch(resp)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
 
-		if appContext == "" {
-			return loc, sel, ErrInvalidCredential
-		}
+	// The client hands back the last response once retries run out, a 429
+	// included
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.Request.URL, nil, fmt.Errorf("server responded with status: %s", resp.Status)
+	}
 
+	loc, sel, err := processFetch(resp)
+	if err != nil {
 		return loc, sel, err
 	}
+	if appContext == "" {
+		return loc, sel, ErrInvalidCredential
+	}
 
-	return nil, nil, fmt.Errorf("request failed after 5 retries")
+	return loc, sel, nil
 }
 
 // Build /app/<appContext>/<paths...>
-func BuildAppPath(paths []string) ([]string, error) {
+func BuildAppPath(ctx context.Context, paths []string) ([]string, error) {
 	if appContext == "" {
-		_, _, err := GetPage(nil, nil)
+		_, _, err := GetPage(ctx, nil, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -127,9 +133,9 @@ func BuildAppPath(paths []string) ([]string, error) {
 }
 
 // Build /app/<appContext>+<sems>/<paths...>
-func BuildAppPathWithSems(paths []string, sems string) ([]string, error) {
+func BuildAppPathWithSems(ctx context.Context, paths []string, sems string) ([]string, error) {
 	if appContext == "" {
-		_, _, err := GetPage(nil, nil)
+		_, _, err := GetPage(ctx, nil, nil)
 		if err != nil {
 			return nil, err
 		}
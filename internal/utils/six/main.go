@@ -1,7 +1,10 @@
 package six
 
-import "kano/internal/utils/six/schedules"
+import (
+	"context"
+	"kano/internal/utils/six/schedules"
+)
 
-func GetAllSchedules() ([]schedules.SemesterSubject, error) {
-	return schedules.GetSchedules()
+func GetAllSchedules(ctx context.Context) ([]schedules.SemesterSubject, error) {
+	return schedules.GetSchedules(ctx)
 }
@@ -1,14 +1,19 @@
 package schedules
 
 import (
+	"context"
 	"fmt"
+	"kano/internal/database"
 
 	"gorm.io/gorm"
 )
 
-func ApplyDiff(sems []SemesterDiff) error {
+// Applies every semester in one transaction, which is rolled back if ctx is
+// cancelled before it commits
+func ApplyDiff(ctx context.Context, sems []SemesterDiff) error {
+	db := database.GetInstance()
 	fmt.Println("There is", len(lecturers), "lecturers in the cache")
-	err := db.Transaction(func(tx *gorm.DB) error {
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		for _, sem := range sems {
 			err := applyPerSemester(tx, sem)
 			if err != nil {
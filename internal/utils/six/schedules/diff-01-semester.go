@@ -2,6 +2,8 @@ package schedules
 
 import (
 	"fmt"
+	"kano/internal/config"
+	"kano/internal/database"
 	"kano/internal/database/models"
 	"kano/internal/utils/datetime"
 	"slices"
@@ -17,6 +19,7 @@ var dbSems models.Semester
 var semsWeekStart time.Time
 
 func generateSemesterDiff(semester SemesterSubject) (SemesterDiff, error) {
+	db := database.GetInstance()
 	res := SemesterDiff{}
 
 	tx := db.
@@ -26,7 +29,7 @@ func generateSemesterDiff(semester SemesterSubject) (SemesterDiff, error) {
 		return res, tx.Error
 	}
 	res.ID = dbSems.ID
-	semsWeekStart = datetime.StartOfWeek(dbSems.Start)
+	semsWeekStart = datetime.StartOfWeek(dbSems.Start, config.GetConfig().Timezone)
 
 	// Ts might slow af
 	var dbClasses []models.SubjectClass
@@ -3,6 +3,7 @@ package schedules
 import (
 	"database/sql"
 	"fmt"
+	"kano/internal/database"
 	"kano/internal/database/models"
 	"slices"
 )
@@ -10,6 +11,7 @@ import (
 // Somehow this only generates the added, removed, and modified classes.
 // Yeah, as described by SubjectDiff's fields.
 func generateSubjectDiff(subject Subject) (SubjectDiff, error) {
+	db := database.GetInstance()
 	res := SubjectDiff{ID: subject.ID}
 	if dbSems.ID == 0 {
 		return res, fmt.Errorf("generateSubjectDiff called before generateSemesterDiff")
@@ -1,6 +1,7 @@
 package schedules
 
 import (
+	"fmt"
 	"kano/internal/database/models"
 	"slices"
 )
@@ -103,43 +104,27 @@ func scheduleDiff(classDiff *ClassDiff, classMod Class, dbClass models.SubjectCl
 		}
 	}
 
-	// classDiff.ModifiedSchedules = make([]ScheduleDiff, 0, len(exists))
-	// for i, schedMod := range exists {
-	// 	var schedDiff ScheduleDiff
-
-	// 	idx := slices.IndexFunc(dbClass.Schedules, func(a models.ClassSchedule) bool { return a.Start.Equal(schedMod.Start) && a.End.Equal(schedMod.End) })
-	// 	if idx == -1 {
-	// 		return fmt.Errorf("schedDiff idx %d: invalid index -1", i)
-	// 	}
-	// 	dbSched := dbClass.Schedules[idx]
-
-	// 	schedDiff.ID = dbSched.ID
-	// 	if string(schedMod.Activity) != string(dbSched.Activity) {
-	// 		schedDiff.Activity = varDiff[Activity]{
-	// 			Before:  Activity(dbSched.Activity),
-	// 			After:   schedMod.Activity,
-	// 			HasDiff: true,
-	// 		}
-	// 	}
-	// 	if string(schedMod.Method) != string(dbSched.Method) {
-	// 		schedDiff.Method = varDiff[Method]{
-	// 			Before:  Method(dbSched.Method),
-	// 			After:   schedMod.Method,
-	// 			HasDiff: true,
-	// 		}
-	// 	}
-	// 	handleRoom(&schedDiff, schedMod, dbSched)
-
-	// 	// Only append if there is any difference!
-	// 	anyDiff := schedDiff.Activity.HasDiff ||
-	// 		schedDiff.Method.HasDiff ||
-	// 		len(schedDiff.AddedRooms) > 0 ||
-	// 		len(schedDiff.RemovedRooms) > 0
-
-	// 	if anyDiff {
-	// 		classDiff.ModifiedSchedules = append(classDiff.ModifiedSchedules, schedDiff)
-	// 	}
-	// }
+	// Schedules are matched by start, end, activity and method above, so the
+	// rooms are the only thing left that can change on an existing one
+	classDiff.ModifiedSchedules = make([]ScheduleDiff, 0, len(exists))
+	for i, schedMod := range exists {
+		idx := slices.IndexFunc(dbClass.Schedules, func(a models.ClassSchedule) bool {
+			return a.Start.Equal(schedMod.Start) &&
+				a.End.Equal(schedMod.End) &&
+				string(a.Activity) == string(schedMod.Activity) &&
+				string(a.Method) == string(schedMod.Method)
+		})
+		if idx == -1 {
+			return fmt.Errorf("schedDiff idx %d: invalid index -1", i)
+		}
+
+		schedDiff := ScheduleDiff{ID: dbClass.Schedules[idx].ID}
+		handleRoom(&schedDiff, schedMod, dbClass.Schedules[idx])
+
+		if len(schedDiff.AddedRooms) > 0 || len(schedDiff.RemovedRooms) > 0 {
+			classDiff.ModifiedSchedules = append(classDiff.ModifiedSchedules, schedDiff)
+		}
+	}
 
 	classDiff.AddedSchedules = added
 	classDiff.RemovedSchedules = removed
@@ -170,6 +155,7 @@ func handleRoom(schedDiff *ScheduleDiff, schedMod Schedule, dbSched models.Class
 
 	schedDiff.AddedRooms = added
 	schedDiff.RemovedRooms = removed
+	cacheRooms(dbSched.Rooms)
 }
 
 // Other func and type that only help
@@ -2,6 +2,7 @@ package schedules
 
 import (
 	"fmt"
+	"kano/internal/database"
 	"kano/internal/database/models"
 	"slices"
 )
@@ -9,6 +10,7 @@ import (
 // See vars.go for related variables
 
 func initLecturers(scheds []SemesterSubject) error {
+	db := database.GetInstance()
 	strLecturers := []string{}
 	for _, sched := range scheds {
 		for _, subject := range sched.Subjects {
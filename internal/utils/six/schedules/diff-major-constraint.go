@@ -3,6 +3,7 @@ package schedules
 import (
 	"database/sql"
 	"fmt"
+	"kano/internal/database"
 	"kano/internal/database/models"
 	"slices"
 )
@@ -10,6 +11,7 @@ import (
 // See vars.go for related variables
 
 func initMajorConstraints(scheds []SemesterSubject) error {
+	db := database.GetInstance()
 	rawMajorConstraints := []MajorConstraint{}
 	for _, sched := range scheds {
 		for _, subject := range sched.Subjects {
@@ -1,6 +1,7 @@
 package schedules
 
 import (
+	"kano/internal/database"
 	"kano/internal/database/models"
 	"slices"
 
@@ -8,6 +9,7 @@ import (
 )
 
 func initMajors(scheds []SemesterSubject) error {
+	db := database.GetInstance()
 	majors := []models.Major{}
 	for _, semester := range scheds {
 		for _, major := range semester.Majors {
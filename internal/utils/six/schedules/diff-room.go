@@ -2,6 +2,7 @@ package schedules
 
 import (
 	"fmt"
+	"kano/internal/database"
 	"kano/internal/database/models"
 	"slices"
 )
@@ -9,6 +10,7 @@ import (
 // See vars.go for related variables
 
 func initRooms(scheds []SemesterSubject) error {
+	db := database.GetInstance()
 	strRooms := []string{}
 	for _, sched := range scheds {
 		for _, subject := range sched.Subjects {
@@ -75,3 +77,13 @@ func findRoomId(roomName string) uint {
 
 	return 0
 }
+
+// Only the rooms of the fetched schedules are cached by initRooms, a removed
+// room may be in none of them but findRoomId still needs it
+func cacheRooms(dbRooms []models.Room) {
+	for _, room := range dbRooms {
+		if findRoomId(room.Name) == 0 {
+			rooms = append(rooms, room)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package schedules
 import (
 	"errors"
 	"fmt"
+	"kano/internal/database"
 	"kano/internal/database/models"
 	"slices"
 
@@ -15,6 +16,7 @@ import (
 const CURRICULA_YEAR = 2024
 
 func initSubjects(scheds []SemesterSubject) error {
+	db := database.GetInstance()
 	subjects := []models.Subject{}
 	for _, semester := range scheds {
 		for _, subject := range semester.Subjects {
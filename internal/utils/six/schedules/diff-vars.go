@@ -1,13 +1,9 @@
 package schedules
 
 import (
-	"kano/internal/database"
 	"kano/internal/database/models"
 )
 
-// Universal
-var db = database.GetInstance()
-
 // lecturer.go
 var lecturers []models.Lecturer
 
@@ -0,0 +1,61 @@
+package schedules
+
+import (
+	"kano/internal/database/models"
+	"slices"
+	"testing"
+)
+
+func TestScheduleDiffRooms(t *testing.T) {
+	dbClass := models.SubjectClass{
+		Schedules: []models.ClassSchedule{
+			{ID: 1, Start: at(0, 7), End: at(0, 9), Activity: "LECTURE", Method: "IN_PERSON", Rooms: []models.Room{{ID: 10, Name: "7602"}, {ID: 11, Name: "7603"}}},
+			{ID: 2, Start: at(2, 7), End: at(2, 9), Activity: "LECTURE", Method: "IN_PERSON", Rooms: []models.Room{{ID: 10, Name: "7602"}}},
+			{ID: 3, Start: at(4, 7), End: at(4, 9), Activity: "QUIZ", Method: "IN_PERSON"},
+		},
+	}
+	classMod := Class{
+		Schedules: []Schedule{
+			// Room 7603 moved to 9009
+			{Start: at(0, 7), End: at(0, 9), Activity: ActivityLecture, Method: MethodInPerson, Rooms: []string{"7602", "9009"}},
+			// Unchanged
+			{Start: at(2, 7), End: at(2, 9), Activity: ActivityLecture, Method: MethodInPerson, Rooms: []string{"7602"}},
+			// Went online, so it is a different schedule
+			{Start: at(4, 7), End: at(4, 9), Activity: ActivityQuiz, Method: MethodOnline},
+		},
+	}
+
+	// initRooms only caches the rooms still in use
+	rooms = []models.Room{{ID: 10, Name: "7602"}, {ID: 12, Name: "9009"}}
+	defer func() { rooms = nil }()
+
+	var d ClassDiff
+	if err := scheduleDiff(&d, classMod, dbClass); err != nil {
+		t.Fatalf("scheduleDiff failed: %s", err)
+	}
+
+	if len(d.ModifiedSchedules) != 1 {
+		t.Fatalf("expected 1 modified schedule, got %+v", d.ModifiedSchedules)
+	}
+	mod := d.ModifiedSchedules[0]
+	if mod.ID != 1 || !slices.Equal(mod.AddedRooms, []string{"9009"}) || !slices.Equal(mod.RemovedRooms, []string{"7603"}) {
+		t.Errorf("unexpected room changes %+v", mod)
+	}
+
+	if len(d.AddedSchedules) != 1 || d.AddedSchedules[0].Method != MethodOnline {
+		t.Errorf("expected the online quiz to be added, got %+v", d.AddedSchedules)
+	}
+	if len(d.RemovedSchedules) != 1 || d.RemovedSchedules[0].ID != 3 {
+		t.Errorf("expected the in person quiz to be removed, got %+v", d.RemovedSchedules)
+	}
+
+	// handleModifiedSchedules aborts the whole update on a room it can't find
+	for _, name := range append(mod.AddedRooms, mod.RemovedRooms...) {
+		if findRoomId(name) == 0 {
+			t.Errorf("room %s has no ID to apply the change with", name)
+		}
+	}
+	if id := findRoomId("7603"); id != 11 {
+		t.Errorf("expected the removed room to keep its ID 11, got %d", id)
+	}
+}
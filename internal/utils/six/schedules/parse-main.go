@@ -1,6 +1,7 @@
 package schedules
 
 import (
+	"context"
 	"fmt"
 	"kano/internal/utils/six/fetcher"
 	"strings"
@@ -8,13 +9,13 @@ import (
 
 var activeOnly bool = true
 
-func GetSchedules() ([]SemesterSubject, error) {
-	path, err := fetcher.BuildAppPath([]string{"kelas"})
+func GetSchedules(ctx context.Context) ([]SemesterSubject, error) {
+	path, err := fetcher.BuildAppPath(ctx, []string{"kelas"})
 	if err != nil {
 		return nil, err
 	}
 
-	_, mainClassPage, err := fetcher.GetPage(path, nil)
+	_, mainClassPage, err := fetcher.GetPage(ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -54,11 +55,11 @@ func GetSchedules() ([]SemesterSubject, error) {
 		}
 
 		// Fetch per semester page and parse it
-		thePage, err := schedQuery(semsCtx, "")
+		thePage, err := schedQuery(ctx, semsCtx, "")
 		if err != nil {
 			return nil, fmt.Errorf("SchedQuery: %s", err)
 		}
-		semsPageData, err := parsePerSemesterContext(semsCtx, thePage)
+		semsPageData, err := parsePerSemesterContext(ctx, semsCtx, thePage)
 		if err != nil {
 			return nil, fmt.Errorf("parsePerSemesterContext: %s", err)
 		}
@@ -1,6 +1,7 @@
 package schedules
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,7 +9,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 )
 
-func parsePerSemesterContext(semsCtx string, page *goquery.Selection) (SemesterSubject, error) {
+func parsePerSemesterContext(ctx context.Context, semsCtx string, page *goquery.Selection) (SemesterSubject, error) {
 	optgroups := page.Find("#prodi optgroup")
 	res := SemesterSubject{}
 
@@ -63,7 +64,7 @@ func parsePerSemesterContext(semsCtx string, page *goquery.Selection) (SemesterS
 
 	// Fetch per major schedule page and parse them
 	for _, major := range res.Majors {
-		thePage, err := schedQuery(semsCtx, fmt.Sprintf("%d", major.ID))
+		thePage, err := schedQuery(ctx, semsCtx, fmt.Sprintf("%d", major.ID))
 		if err != nil {
 			return res, fmt.Errorf("major %d: SchedQuery: %s", major.ID, err)
 		}
@@ -0,0 +1,398 @@
+package schedules
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"kano/internal/database"
+	"kano/internal/database/models"
+	"kano/internal/utils/chatutil/contactutil"
+	"kano/internal/utils/i18n"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// Long lists (a weekly class moved to another day changes every meeting) are
+// cut after this many lines
+const renderListLimit = 3
+
+var activityMessages = map[Activity]i18n.MessageID{
+	ActivityLecture:  "schedules.activity.lecture",
+	ActivityTutorial: "schedules.activity.tutorial",
+	ActivityLabWork:  "schedules.activity.lab_work",
+	ActivityQuiz:     "schedules.activity.quiz",
+	ActivityMidterm:  "schedules.activity.midterm",
+	ActivityFinal:    "schedules.activity.final",
+}
+
+var methodMessages = map[Method]i18n.MessageID{
+	MethodInPerson: "schedules.method.in_person",
+	MethodOnline:   "schedules.method.online",
+	MethodHybrid:   "schedules.method.hybrid",
+}
+
+// Builds one message per follower describing every followed class touched by
+// the diff, in the follower's own language. Must be called before ApplyDiff,
+// removed classes and their followers are gone after that.
+func FollowerUpdates(ctx context.Context, diffs []SemesterDiff, loc *time.Location) (map[types.JID]string, error) {
+	db := database.GetInstance().WithContext(ctx)
+
+	removedSubjects := [][2]uint{}
+	removedClasses := []uint{}
+	modifiedClasses := []ClassDiff{}
+	schedIds := []uint{}
+	for _, sems := range diffs {
+		for _, subj := range sems.RemovedSubjects {
+			removedSubjects = append(removedSubjects, [2]uint{sems.ID, subj.ID})
+		}
+		for _, subj := range sems.ModifiedSubjects {
+			for _, class := range subj.RemovedClasses {
+				removedClasses = append(removedClasses, class.ID)
+			}
+			for _, class := range subj.ModifiedClasses {
+				modifiedClasses = append(modifiedClasses, class)
+				for _, sched := range class.ModifiedSchedules {
+					schedIds = append(schedIds, sched.ID)
+				}
+			}
+		}
+	}
+
+	var subjectClasses []uint
+	if len(removedSubjects) > 0 {
+		tx := db.Model(&models.SubjectClass{}).
+			Where("(semester_id, subject_id) IN ?", removedSubjects).
+			Pluck("id", &subjectClasses)
+		if tx.Error != nil {
+			return nil, fmt.Errorf("failed to get classes of removed subjects: %s", tx.Error)
+		}
+	}
+
+	scheds := map[uint]models.ClassSchedule{}
+	if len(schedIds) > 0 {
+		var found []models.ClassSchedule
+		tx := db.Where("id IN ?", schedIds).Find(&found)
+		if tx.Error != nil {
+			return nil, fmt.Errorf("failed to get modified schedules: %s", tx.Error)
+		}
+		for _, s := range found {
+			scheds[s.ID] = s
+		}
+	}
+
+	// Class ID -> lines describing what happened to it
+	render := func(lang i18n.Lang) map[uint][]string {
+		changes := map[uint][]string{}
+		for _, id := range removedClasses {
+			changes[id] = []string{i18n.T(lang, "schedules.class_removed")}
+		}
+		for _, id := range subjectClasses {
+			changes[id] = []string{i18n.T(lang, "schedules.subject_removed")}
+		}
+		for _, class := range modifiedClasses {
+			if lines := renderClassDiff(lang, class, scheds, loc); len(lines) > 0 {
+				changes[class.ID] = lines
+			}
+		}
+		return changes
+	}
+
+	// Which classes changed doesn't depend on the language
+	changes := map[i18n.Lang]map[uint][]string{i18n.Default: render(i18n.Default)}
+	if len(changes[i18n.Default]) == 0 {
+		return nil, nil
+	}
+
+	classIds := make([]uint, 0, len(changes[i18n.Default]))
+	for id := range changes[i18n.Default] {
+		classIds = append(classIds, id)
+	}
+
+	var followers []models.ClassFollower
+	tx := db.Select("jid", "subject_class_id").Where("subject_class_id IN ?", classIds).Find(&followers)
+	if tx.Error != nil {
+		return nil, fmt.Errorf("failed to get class followers: %s", tx.Error)
+	}
+	if len(followers) == 0 {
+		return nil, nil
+	}
+
+	var classes []models.SubjectClass
+	tx = db.Preload("Subject").Where("id IN ?", classIds).Find(&classes)
+	if tx.Error != nil {
+		return nil, fmt.Errorf("failed to get followed classes: %s", tx.Error)
+	}
+	slices.SortFunc(classes, func(a, b models.SubjectClass) int {
+		return cmp.Or(strings.Compare(a.Subject.Code, b.Subject.Code), cmp.Compare(a.Number, b.Number))
+	})
+
+	followed := map[types.JID][]uint{}
+	jids := []types.JID{}
+	for _, f := range followers {
+		if _, ok := followed[f.Jid]; !ok {
+			jids = append(jids, f.Jid)
+		}
+		followed[f.Jid] = append(followed[f.Jid], f.SubjectClassID)
+	}
+
+	langs, err := contactutil.Langs(ctx, jids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follower languages: %s", err)
+	}
+
+	res := make(map[types.JID]string, len(followed))
+	for jid, ids := range followed {
+		lang := langs[jid]
+		if _, ok := changes[lang]; !ok {
+			changes[lang] = render(lang)
+		}
+
+		var builder strings.Builder
+		builder.WriteString(i18n.T(lang, "schedules.updates_header"))
+
+		for _, class := range classes {
+			if !slices.Contains(ids, class.ID) {
+				continue
+			}
+
+			fmt.Fprintf(&builder, "\n\n*%s-%02d %s*", class.Subject.Code, class.Number, class.Subject.Name)
+			for _, line := range changes[lang][class.ID] {
+				fmt.Fprintf(&builder, "\n- %s", line)
+			}
+		}
+
+		res[jid] = builder.String()
+	}
+
+	return res, nil
+}
+
+// scheds holds the modified schedules by ID, the diff only carries their IDs
+func renderClassDiff(lang i18n.Lang, d ClassDiff, scheds map[uint]models.ClassSchedule, loc *time.Location) []string {
+	lines := []string{}
+
+	if d.Number.HasDiff {
+		lines = append(lines, i18n.T(lang, "schedules.number_changed", d.Number.Before, d.Number.After))
+	}
+	if d.Quota.HasDiff {
+		lines = append(lines, i18n.T(lang, "schedules.quota_changed", renderQuota(lang, d.Quota.Before), renderQuota(lang, d.Quota.After)))
+	}
+	if d.Constraints.HasDiff {
+		lines = append(lines, i18n.T(lang, "schedules.constraints_changed"))
+	}
+
+	if len(d.AddedLecturers) > 0 {
+		lines = append(lines, i18n.T(lang, "schedules.lecturers_added", strings.Join(d.AddedLecturers, ", ")))
+	}
+	if len(d.RemovedLecturers) > 0 {
+		lines = append(lines, i18n.T(lang, "schedules.lecturers_removed", strings.Join(d.RemovedLecturers, ", ")))
+	}
+
+	if d.Links.HasDiff {
+		before, after := d.Links.Before, d.Links.After
+		if after.Teams != before.Teams {
+			if after.Teams != "" {
+				lines = append(lines, i18n.T(lang, "schedules.teams_added", after.Teams))
+			} else {
+				lines = append(lines, i18n.T(lang, "schedules.teams_removed"))
+			}
+		}
+		if after.EdunexClassId != before.EdunexClassId {
+			if after.EdunexClassId.Valid {
+				lines = append(lines, i18n.T(lang, "schedules.edunex_added", after.EdunexClassId.Int32))
+			} else {
+				lines = append(lines, i18n.T(lang, "schedules.edunex_removed"))
+			}
+		}
+	}
+
+	lines = append(lines, renderScheduleChanges(lang, d.AddedSchedules, d.RemovedSchedules, loc)...)
+	lines = append(lines, renderRoomChanges(lang, d.ModifiedSchedules, scheds, loc)...)
+
+	return lines
+}
+
+// A removed and an added schedule of the same activity are shown as a move
+func renderScheduleChanges(lang i18n.Lang, added []Schedule, removed []models.ClassSchedule, loc *time.Location) []string {
+	added = slices.Clone(added)
+	removed = slices.Clone(removed)
+	slices.SortFunc(added, func(a, b Schedule) int { return a.Start.Compare(b.Start) })
+	slices.SortFunc(removed, func(a, b models.ClassSchedule) int { return a.Start.Compare(b.Start) })
+
+	moved := []string{}
+	used := make([]bool, len(added))
+	left := []models.ClassSchedule{}
+	for _, r := range removed {
+		idx := -1
+		for i, a := range added {
+			if !used[i] && string(a.Activity) == string(r.Activity) {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			left = append(left, r)
+			continue
+		}
+
+		used[idx] = true
+		a := added[idx]
+		if a.Start.Equal(r.Start) && a.End.Equal(r.End) {
+			// Same time, so only the method changed
+			method := i18n.T(lang, "schedules.method.other")
+			if msgId, ok := methodMessages[a.Method]; ok {
+				method = i18n.T(lang, msgId)
+			}
+			moved = append(moved, i18n.T(lang, "schedules.method_changed",
+				renderActivity(lang, a.Activity),
+				RenderTimeRange(lang, a.Start, a.End, loc),
+				method,
+			))
+			continue
+		}
+		line := i18n.T(lang, "schedules.moved",
+			renderActivity(lang, Activity(r.Activity)),
+			RenderTimeRange(lang, r.Start, r.End, loc),
+			RenderTimeRange(lang, a.Start, a.End, loc),
+		)
+		if len(a.Rooms) > 0 {
+			line += i18n.T(lang, "schedules.in_rooms", strings.Join(a.Rooms, ", "))
+		}
+		moved = append(moved, line)
+	}
+
+	newScheds := []string{}
+	for i, a := range added {
+		if used[i] {
+			continue
+		}
+		line := i18n.T(lang, "schedules.added", renderActivity(lang, a.Activity), RenderTimeRange(lang, a.Start, a.End, loc))
+		if msgId, ok := methodMessages[a.Method]; ok {
+			line += " (" + i18n.T(lang, msgId) + ")"
+		}
+		if len(a.Rooms) > 0 {
+			line += i18n.T(lang, "schedules.in_rooms", strings.Join(a.Rooms, ", "))
+		}
+		newScheds = append(newScheds, line)
+	}
+
+	gone := make([]string, len(left))
+	for i, r := range left {
+		gone[i] = i18n.T(lang, "schedules.removed", renderActivity(lang, Activity(r.Activity)), RenderTimeRange(lang, r.Start, r.End, loc))
+	}
+
+	lines := capLines(lang, moved, "schedules.more_moved")
+	lines = append(lines, capLines(lang, newScheds, "schedules.more_added")...)
+	return append(lines, capLines(lang, gone, "schedules.more_removed")...)
+}
+
+// Room changes shared by several meetings are shown once
+func renderRoomChanges(lang i18n.Lang, mods []ScheduleDiff, scheds map[uint]models.ClassSchedule, loc *time.Location) []string {
+	type group struct {
+		activity string
+		rooms    string
+		first    models.ClassSchedule
+		count    int
+	}
+	groups := []*group{}
+
+	for _, mod := range mods {
+		parts := []string{}
+		if len(mod.AddedRooms) > 0 {
+			parts = append(parts, i18n.T(lang, "schedules.rooms_added", strings.Join(mod.AddedRooms, ", ")))
+		}
+		if len(mod.RemovedRooms) > 0 {
+			parts = append(parts, i18n.T(lang, "schedules.rooms_removed", strings.Join(mod.RemovedRooms, ", ")))
+		}
+		sched, ok := scheds[mod.ID]
+		if len(parts) == 0 || !ok {
+			continue
+		}
+		activity := renderActivity(lang, Activity(sched.Activity))
+		rooms := strings.Join(parts, ", ")
+
+		idx := slices.IndexFunc(groups, func(g *group) bool { return g.activity == activity && g.rooms == rooms })
+		if idx == -1 {
+			groups = append(groups, &group{activity: activity, rooms: rooms, first: sched, count: 1})
+			continue
+		}
+		g := groups[idx]
+		g.count++
+		if sched.Start.Before(g.first.Start) {
+			g.first = sched
+		}
+	}
+
+	lines := make([]string, len(groups))
+	for i, g := range groups {
+		if g.count == 1 {
+			lines[i] = i18n.T(lang, "schedules.room_changed", g.activity, RenderTimeRange(lang, g.first.Start, g.first.End, loc), g.rooms)
+		} else {
+			lines[i] = i18n.T(lang, "schedules.room_changed_many", g.activity, g.count, RenderDate(lang, g.first.Start, loc), g.rooms)
+		}
+	}
+
+	return lines
+}
+
+func capLines(lang i18n.Lang, lines []string, more i18n.MessageID) []string {
+	if len(lines) <= renderListLimit {
+		return lines
+	}
+	return append(lines[:renderListLimit], i18n.T(lang, more, len(lines)-renderListLimit))
+}
+
+func renderQuota(lang i18n.Lang, q sql.NullInt32) string {
+	if !q.Valid {
+		return i18n.T(lang, "schedules.quota_none")
+	}
+	return fmt.Sprint(q.Int32)
+}
+
+func renderActivity(lang i18n.Lang, a Activity) string {
+	if msgId, ok := activityMessages[a]; ok {
+		return i18n.T(lang, msgId)
+	}
+	return i18n.T(lang, "schedules.activity.other")
+}
+
+// One timetable line, the schedule needs its SubjectClass.Subject and Rooms
+// loaded, e.g. "07.00-09.00 IF2110-01 Algoritma (Kuliah, tatap muka) di 7602"
+func RenderTimetableEntry(lang i18n.Lang, s models.ClassSchedule, loc *time.Location) string {
+	start, end := s.Start.In(loc), s.End.In(loc)
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%02d.%02d-%02d.%02d", start.Hour(), start.Minute(), end.Hour(), end.Minute())
+	if class := s.SubjectClass; class != nil {
+		fmt.Fprintf(&builder, " %s-%02d %s", class.Subject.Code, class.Number, class.Subject.Name)
+	}
+
+	fmt.Fprintf(&builder, " (%s", renderActivity(lang, Activity(s.Activity)))
+	if msgId, ok := methodMessages[Method(s.Method)]; ok {
+		builder.WriteString(", " + i18n.T(lang, msgId))
+	}
+	builder.WriteString(")")
+
+	if len(s.Rooms) > 0 {
+		builder.WriteString(i18n.T(lang, "schedules.in_rooms", strings.Join(modelRoomToString(s.Rooms), ", ")))
+	}
+	return builder.String()
+}
+
+// e.g. "Senin, 16 Feb"
+func RenderDate(lang i18n.Lang, t time.Time, loc *time.Location) string {
+	t = t.In(loc)
+	weekday := i18n.T(lang, i18n.MessageID(fmt.Sprintf("schedules.weekday.%d", t.Weekday())))
+	month := i18n.T(lang, i18n.MessageID(fmt.Sprintf("schedules.month.%d", t.Month())))
+	return fmt.Sprintf("%s, %d %s", weekday, t.Day(), month)
+}
+
+// e.g. "Senin, 16 Feb 07.00-09.00"
+func RenderTimeRange(lang i18n.Lang, start, end time.Time, loc *time.Location) string {
+	start, end = start.In(loc), end.In(loc)
+	return fmt.Sprintf("%s %02d.%02d-%02d.%02d", RenderDate(lang, start, loc), start.Hour(), start.Minute(), end.Hour(), end.Minute())
+}
@@ -0,0 +1,219 @@
+package schedules
+
+import (
+	"database/sql"
+	"fmt"
+	"kano/internal/database/models"
+	"kano/internal/utils/i18n"
+	"slices"
+	"testing"
+	"time"
+)
+
+var wib = time.FixedZone("WIB", 7*60*60)
+
+// Monday 16 Feb 2026 at the given hour in WIB, shifted by days
+func at(days, hour int) time.Time {
+	return time.Date(2026, 2, 16+days, hour, 0, 0, 0, wib)
+}
+
+func TestRenderScheduleChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     i18n.Lang
+		added    []Schedule
+		removed  []models.ClassSchedule
+		expected []string
+	}{
+		{
+			name:     "moved",
+			lang:     i18n.LangEN,
+			added:    []Schedule{{Start: at(1, 9), End: at(1, 11), Activity: ActivityLecture, Rooms: []string{"7602"}}},
+			removed:  []models.ClassSchedule{{Start: at(0, 7), End: at(0, 9), Activity: "LECTURE"}},
+			expected: []string{"Lecture moved from Monday, 16 Feb 07.00-09.00 to Tuesday, 17 Feb 09.00-11.00 in 7602"},
+		},
+		{
+			name:     "moved_id",
+			lang:     i18n.LangID,
+			added:    []Schedule{{Start: at(1, 9), End: at(1, 11), Activity: ActivityLecture}},
+			removed:  []models.ClassSchedule{{Start: at(0, 7), End: at(0, 9), Activity: "LECTURE"}},
+			expected: []string{"Kuliah dipindah dari Senin, 16 Feb 07.00-09.00 ke Selasa, 17 Feb 09.00-11.00"},
+		},
+		{
+			name:     "method_changed",
+			lang:     i18n.LangEN,
+			added:    []Schedule{{Start: at(0, 7), End: at(0, 9), Activity: ActivityQuiz, Method: MethodOnline}},
+			removed:  []models.ClassSchedule{{Start: at(0, 7), End: at(0, 9), Activity: "QUIZ", Method: "IN_PERSON"}},
+			expected: []string{"Quiz Monday, 16 Feb 07.00-09.00 is now online"},
+		},
+		{
+			name:    "different_activities_are_not_moves",
+			lang:    i18n.LangEN,
+			added:   []Schedule{{Start: at(2, 13), End: at(2, 15), Activity: ActivityLabWork, Method: MethodInPerson, Rooms: []string{"Labdas"}}},
+			removed: []models.ClassSchedule{{Start: at(0, 7), End: at(0, 9), Activity: "TUTORIAL"}},
+			expected: []string{
+				"New schedule: Lab work Wednesday, 18 Feb 13.00-15.00 (in person) in Labdas",
+				"Schedule removed: Tutorial Monday, 16 Feb 07.00-09.00",
+			},
+		},
+		{
+			name: "capped",
+			lang: i18n.LangEN,
+			added: []Schedule{
+				{Start: at(7, 7), End: at(7, 9), Activity: ActivityLecture},
+				{Start: at(0, 7), End: at(0, 9), Activity: ActivityLecture},
+				{Start: at(14, 7), End: at(14, 9), Activity: ActivityLecture},
+				{Start: at(21, 7), End: at(21, 9), Activity: ActivityLecture},
+				{Start: at(28, 7), End: at(28, 9), Activity: ActivityLecture},
+			},
+			expected: []string{
+				"New schedule: Lecture Monday, 16 Feb 07.00-09.00",
+				"New schedule: Lecture Monday, 23 Feb 07.00-09.00",
+				"New schedule: Lecture Monday, 2 Mar 07.00-09.00",
+				"...and 2 more new schedules",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderScheduleChanges(tt.lang, tt.added, tt.removed, wib)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("expected\n%q\ngot\n%q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRenderRoomChanges(t *testing.T) {
+	lecture := func(id uint, days int) models.ClassSchedule {
+		return models.ClassSchedule{ID: id, Start: at(days, 7), End: at(days, 9), Activity: "LECTURE"}
+	}
+	scheds := map[uint]models.ClassSchedule{
+		1: lecture(1, 7),
+		2: lecture(2, 0),
+		3: lecture(3, 14),
+		4: {ID: 4, Start: at(2, 13), End: at(2, 15), Activity: "LAB_WORK"},
+	}
+
+	tests := []struct {
+		name     string
+		lang     i18n.Lang
+		mods     []ScheduleDiff
+		expected []string
+	}{
+		{
+			name:     "single",
+			lang:     i18n.LangEN,
+			mods:     []ScheduleDiff{{ID: 4, AddedRooms: []string{"Labdas"}, RemovedRooms: []string{"7602"}}},
+			expected: []string{"Rooms of Lab work Wednesday, 18 Feb 13.00-15.00: added Labdas, removed 7602"},
+		},
+		{
+			name: "grouped_from_the_earliest",
+			lang: i18n.LangEN,
+			mods: []ScheduleDiff{
+				{ID: 1, AddedRooms: []string{"9009"}},
+				{ID: 2, AddedRooms: []string{"9009"}},
+				{ID: 3, AddedRooms: []string{"9009"}},
+				{ID: 4, AddedRooms: []string{"9009"}},
+			},
+			expected: []string{
+				"Rooms of Lecture in 3 meetings from Monday, 16 Feb: added 9009",
+				"Rooms of Lab work Wednesday, 18 Feb 13.00-15.00: added 9009",
+			},
+		},
+		{
+			name:     "id",
+			lang:     i18n.LangID,
+			mods:     []ScheduleDiff{{ID: 2, RemovedRooms: []string{"7602", "7603"}}},
+			expected: []string{"Ruangan Kuliah Senin, 16 Feb 07.00-09.00 dihapus 7602, 7603"},
+		},
+		{
+			name: "unknown_or_empty_skipped",
+			lang: i18n.LangEN,
+			mods: []ScheduleDiff{
+				{ID: 99, AddedRooms: []string{"9009"}},
+				{ID: 1},
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderRoomChanges(tt.lang, tt.mods, scheds, wib)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("expected\n%q\ngot\n%q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCapLines(t *testing.T) {
+	lines := func(n int) []string {
+		res := make([]string, n)
+		for i := range res {
+			res[i] = fmt.Sprint(i)
+		}
+		return res
+	}
+
+	tests := []struct {
+		name     string
+		lang     i18n.Lang
+		lines    []string
+		expected []string
+	}{
+		{"empty", i18n.LangEN, nil, nil},
+		{"at_limit", i18n.LangEN, lines(renderListLimit), lines(renderListLimit)},
+		{"over_limit", i18n.LangEN, lines(5), []string{"0", "1", "2", "...and 2 more schedules moved"}},
+		{"over_limit_id", i18n.LangID, lines(4), []string{"0", "1", "2", "...dan 1 jadwal lain juga dipindah"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := capLines(tt.lang, tt.lines, "schedules.more_moved"); !slices.Equal(got, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRenderClassDiff(t *testing.T) {
+	d := ClassDiff{
+		Quota:          varDiff[sql.NullInt32]{Before: sql.NullInt32{}, After: sql.NullInt32{Int32: 40, Valid: true}, HasDiff: true},
+		AddedLecturers: []string{"Budi"},
+		Links: varDiff[ClassLink]{
+			Before:  ClassLink{Teams: "https://teams/old"},
+			After:   ClassLink{EdunexClassId: sql.NullInt32{Int32: 12, Valid: true}},
+			HasDiff: true,
+		},
+	}
+
+	tests := []struct {
+		lang     i18n.Lang
+		expected []string
+	}{
+		{i18n.LangEN, []string{
+			"Quota changed from none to 40",
+			"New lecturers: Budi",
+			"Teams link removed",
+			"New Edunex link: https://edunex.itb.ac.id/courses/12",
+		}},
+		{i18n.LangID, []string{
+			"Kuota berubah dari " + i18n.T(i18n.LangID, "schedules.quota_none") + " menjadi 40",
+			i18n.T(i18n.LangID, "schedules.lecturers_added", "Budi"),
+			i18n.T(i18n.LangID, "schedules.teams_removed"),
+			i18n.T(i18n.LangID, "schedules.edunex_added", 12),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.lang), func(t *testing.T) {
+			got := renderClassDiff(tt.lang, d, nil, wib)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("expected\n%q\ngot\n%q", tt.expected, got)
+			}
+		})
+	}
+}
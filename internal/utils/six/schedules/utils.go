@@ -1,6 +1,7 @@
 package schedules
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"kano/internal/utils/six/fetcher"
@@ -63,7 +64,7 @@ func parseSemsData(li *goquery.Selection) (uint, uint, error) {
 	return uint(semsYear), uint(semsNum), nil
 }
 
-func schedQuery(semsCtx, prodiId string) (*goquery.Selection, error) {
+func schedQuery(ctx context.Context, semsCtx, prodiId string) (*goquery.Selection, error) {
 	fPath := path.Join("tmp", "schedules")
 	_, err := os.Stat(fPath)
 	if err != nil {
@@ -91,7 +92,7 @@ func schedQuery(semsCtx, prodiId string) (*goquery.Selection, error) {
 		return d.Selection, e
 	}
 
-	thePath, err := fetcher.BuildAppPathWithSems([]string{"kelas", "jadwal", "kuliah"}, semsCtx)
+	thePath, err := fetcher.BuildAppPathWithSems(ctx, []string{"kelas", "jadwal", "kuliah"}, semsCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +100,7 @@ func schedQuery(semsCtx, prodiId string) (*goquery.Selection, error) {
 		"fakultas": {""},
 		"prodi":    {prodiId},
 	}
-	_, p, e := fetcher.GetPage(thePath, query)
+	_, p, e := fetcher.GetPage(ctx, thePath, query)
 
 	htm, err := p.Html()
 	if err == nil && !errors.Is(e, fetcher.ErrInvalidCredential) {
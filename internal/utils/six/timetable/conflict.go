@@ -0,0 +1,62 @@
+package timetable
+
+import (
+	"slices"
+	"time"
+)
+
+// One meeting of a class, only its times matter here
+type Entry struct {
+	Class      uint
+	Start, End time.Time
+}
+
+// A and B follow the order the classes were given in
+type Pair struct{ A, B uint }
+
+type Conflict struct {
+	Pair
+	// Meetings of A and B that overlap each other
+	Count int
+	// The first overlap
+	Start, End time.Time
+}
+
+// Finds every pair of classes meeting at the same time, listed by their first
+// overlap. order holds the class IDs in the order they were given.
+func Conflicts(order []uint, entries []Entry) []Conflict {
+	entries = slices.Clone(entries)
+	slices.SortStableFunc(entries, func(a, b Entry) int { return a.Start.Compare(b.Start) })
+
+	conflicts := []Conflict{}
+	found := map[Pair]int{}
+	// Sorted by start, so only the entries starting before this one ends can
+	// overlap with it
+	for i, a := range entries {
+		for _, b := range entries[i+1:] {
+			if !b.Start.Before(a.End) {
+				break
+			}
+			if a.Class == b.Class {
+				continue
+			}
+
+			pair := Pair{a.Class, b.Class}
+			if slices.Index(order, pair.A) > slices.Index(order, pair.B) {
+				pair.A, pair.B = pair.B, pair.A
+			}
+			idx, ok := found[pair]
+			if !ok {
+				idx = len(conflicts)
+				found[pair] = idx
+				end := a.End
+				if b.End.Before(end) {
+					end = b.End
+				}
+				conflicts = append(conflicts, Conflict{Pair: pair, Start: b.Start, End: end})
+			}
+			conflicts[idx].Count++
+		}
+	}
+	return conflicts
+}
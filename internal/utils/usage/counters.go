@@ -0,0 +1,58 @@
+package usage
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Dispatches since startup, kept in memory so metrics don't hit the database
+type Counter struct {
+	Command  string
+	Outcome  Outcome
+	Count    uint64
+	Duration time.Duration
+}
+
+type counterKey struct {
+	command string
+	outcome Outcome
+}
+
+var (
+	counters   = map[counterKey]*Counter{}
+	countersMu sync.Mutex
+)
+
+func count(command string, outcome Outcome, d time.Duration) {
+	countersMu.Lock()
+	defer countersMu.Unlock()
+
+	key := counterKey{command, outcome}
+	c, ok := counters[key]
+	if !ok {
+		c = &Counter{Command: command, Outcome: outcome}
+		counters[key] = c
+	}
+	c.Count++
+	c.Duration += d
+}
+
+// Sorted by command, then outcome
+func Counters() []Counter {
+	countersMu.Lock()
+	res := make([]Counter, 0, len(counters))
+	for _, c := range counters {
+		res = append(res, *c)
+	}
+	countersMu.Unlock()
+
+	slices.SortFunc(res, func(a, b Counter) int {
+		if n := strings.Compare(a.Command, b.Command); n != 0 {
+			return n
+		}
+		return strings.Compare(string(a.Outcome), string(b.Outcome))
+	})
+	return res
+}
@@ -0,0 +1,44 @@
+package usage
+
+import (
+	"database/sql"
+	"time"
+)
+
+type CommandStats struct {
+	Command string
+	Total   int64
+	Errors  int64
+	// 95th percentile of the handled dispatches, denied, rate limited and
+	// invalid ones are left out so they don't drag it down
+	P95Ms sql.NullFloat64
+}
+
+func (s CommandStats) ErrorRate() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Total)
+}
+
+// Per command stats since the given time, busiest first
+func Summary(since time.Time, limit int) ([]CommandStats, error) {
+	var stats []CommandStats
+	tx := db.Raw(`
+		SELECT
+			command,
+			count(*) AS total,
+			count(*) FILTER (WHERE outcome IN (?, ?)) AS errors,
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY duration_ms) FILTER (WHERE outcome IN (?, ?, ?)) AS p95_ms
+		FROM command_usage
+		WHERE created_at >= ?
+		GROUP BY command
+		ORDER BY total DESC, command
+		LIMIT ?`,
+		string(OutcomeError), string(OutcomePanic),
+		string(OutcomeOK), string(OutcomeError), string(OutcomePanic),
+		since, limit,
+	).Scan(&stats)
+
+	return stats, tx.Error
+}
@@ -0,0 +1,76 @@
+package usage
+
+import (
+	"database/sql"
+	"kano/internal/config"
+	"kano/internal/database"
+	"kano/internal/database/models"
+	"kano/internal/utils/messageutil"
+	"time"
+)
+
+type Outcome string
+
+const (
+	OutcomeOK          Outcome = "ok"
+	OutcomeError       Outcome = "error"
+	OutcomePanic       Outcome = "panic"
+	OutcomeDenied      Outcome = "denied"
+	OutcomeRateLimited Outcome = "rate_limited"
+	OutcomeInvalidArgs Outcome = "invalid_args"
+)
+
+var db = database.GetInstance()
+var log = config.GetLogger().Sub("Usage")
+
+// A single dispatch being timed
+type Entry struct {
+	Command    string
+	Alias      string
+	Subcommand string
+
+	groupId   sql.NullInt32
+	contactId sql.NullInt32
+	start     time.Time
+}
+
+// Starts timing a dispatch. command is the canonical name and alias is what
+// the user actually typed.
+func Start(c *messageutil.MessageContext, command, alias string) *Entry {
+	e := Entry{Command: command, Alias: alias, start: time.Now()}
+	if c.Group != nil && c.Group.ID != 0 {
+		e.groupId = sql.NullInt32{Int32: int32(c.Group.ID), Valid: true}
+	}
+	if c.Contact != nil {
+		e.contactId = sql.NullInt32{Int32: int32(c.Contact.ID), Valid: true}
+	}
+	return &e
+}
+
+// Stores the dispatch. A non-nil err turns an OK outcome into an error one.
+// Failures are only logged, analytics must never break a command.
+func (e *Entry) Finish(outcome Outcome, err error) {
+	if err != nil && outcome == OutcomeOK {
+		outcome = OutcomeError
+	}
+
+	elapsed := time.Since(e.start)
+	count(e.Command, outcome, elapsed)
+
+	row := models.CommandUsage{
+		Command:    e.Command,
+		Alias:      e.Alias,
+		Subcommand: sql.NullString{String: e.Subcommand, Valid: e.Subcommand != ""},
+		GroupId:    e.groupId,
+		ContactId:  e.contactId,
+		DurationMs: elapsed.Milliseconds(),
+		Outcome:    string(outcome),
+	}
+	if err != nil {
+		row.Error = sql.NullString{String: err.Error(), Valid: true}
+	}
+
+	if tx := db.Create(&row); tx.Error != nil {
+		log.Errorf("Failed to record usage of %s: %s", e.Command, tx.Error)
+	}
+}
@@ -0,0 +1,13 @@
+package word
+
+import "strings"
+
+// Digit of a keycap emoji like 1️⃣, which is the digit, an optional U+FE0F
+// and U+20E3
+func KeycapDigit(s string) (int, bool) {
+	digit, ok := strings.CutSuffix(strings.ReplaceAll(s, "\uFE0F", ""), "\u20E3")
+	if !ok || len(digit) != 1 || !IsCharNumber(digit[0]) {
+		return 0, false
+	}
+	return int(digit[0] - '0'), true
+}
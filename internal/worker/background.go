@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Background runs work that outlives the handler that started it, e.g.
+// `six update` and `admin broadcast`. The work gets its own deadline, but is
+// still cancelled with the parent context and waited for on shutdown.
+type Background struct {
+	ctx context.Context
+
+	mu      sync.Mutex
+	closed  bool
+	running sync.WaitGroup
+}
+
+func NewBackground(ctx context.Context) *Background {
+	return &Background{ctx: ctx}
+}
+
+// Go runs fn in a new goroutine with a context that is done after timeout or
+// when the parent is. Returns false if Wait was called already and fn didn't
+// run.
+func (b *Background) Go(timeout time.Duration, fn func(ctx context.Context)) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, timeout)
+	b.running.Go(func() {
+		defer cancel()
+		fn(ctx)
+	})
+	return true
+}
+
+// Wait stops accepting work and waits for the running one to finish, or for
+// ctx to be done.
+func (b *Background) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var defaultBackground atomic.Pointer[Background]
+
+// Makes b the one used by handlers through Go
+func SetBackground(b *Background) {
+	defaultBackground.Store(b)
+}
+
+// Go runs fn on the Background set with SetBackground, or detached from
+// everything if there is none
+func Go(timeout time.Duration, fn func(ctx context.Context)) bool {
+	if b := defaultBackground.Load(); b != nil {
+		return b.Go(timeout, fn)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	go func() {
+		defer cancel()
+		fn(ctx)
+	}()
+	return true
+}
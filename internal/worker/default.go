@@ -0,0 +1,15 @@
+package worker
+
+import "sync/atomic"
+
+var defaultPool atomic.Pointer[Pool]
+
+// Makes the pool reachable for status reports, e.g. `.admin status`
+func SetDefault(p *Pool) {
+	defaultPool.Store(p)
+}
+
+// The pool set with SetDefault, nil if none
+func Default() *Pool {
+	return defaultPool.Load()
+}
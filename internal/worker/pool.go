@@ -0,0 +1,147 @@
+package worker
+
+import (
+	"context"
+	"sync"
+)
+
+// Pool runs jobs on a fixed number of goroutines. Jobs sharing the same key
+// run one at a time in submission order, jobs with different keys may run
+// concurrently.
+type Pool struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	pending map[string][]func()
+	ready   []string // Keys with pending jobs that no worker is running
+	active  map[string]bool
+
+	queued    int
+	running   int
+	rejected  uint64
+	maxQueued int
+	workers   int
+
+	closed bool
+	exited sync.WaitGroup
+}
+
+type Stats struct {
+	Workers   int
+	Running   int
+	Queued    int
+	MaxQueued int
+	Rejected  uint64
+}
+
+func NewPool(workers, maxQueued int) *Pool {
+	p := &Pool{
+		pending:   map[string][]func(){},
+		active:    map[string]bool{},
+		maxQueued: maxQueued,
+		workers:   workers,
+	}
+	p.cond = sync.NewCond(&p.mu)
+
+	for range workers {
+		p.exited.Go(p.work)
+	}
+
+	return p
+}
+
+// Submit queues the job under the given key. Returns false if the queue is
+// full or the pool is closed and the job was dropped.
+func (p *Pool) Submit(key string, job func()) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return false
+	}
+	if p.queued >= p.maxQueued {
+		p.rejected++
+		return false
+	}
+
+	p.pending[key] = append(p.pending[key], job)
+	p.queued++
+	if !p.active[key] {
+		p.active[key] = true
+		p.ready = append(p.ready, key)
+		p.cond.Signal()
+	}
+
+	return true
+}
+
+func (p *Pool) Stats() Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return Stats{
+		Workers:   p.workers,
+		Running:   p.running,
+		Queued:    p.queued,
+		MaxQueued: p.maxQueued,
+		Rejected:  p.rejected,
+	}
+}
+
+// Close stops accepting jobs and waits for the queued and running ones to
+// finish, or for ctx to be done. Jobs still queued at that point are left to
+// the workers that keep draining in the background.
+func (p *Pool) Close(ctx context.Context) error {
+	p.mu.Lock()
+	p.closed = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.exited.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *Pool) work() {
+	for {
+		p.mu.Lock()
+		for len(p.ready) == 0 && !p.closed {
+			p.cond.Wait()
+		}
+		if len(p.ready) == 0 {
+			p.mu.Unlock()
+			return
+		}
+
+		key := p.ready[0]
+		p.ready = p.ready[1:]
+		job := p.pending[key][0]
+		p.pending[key] = p.pending[key][1:]
+		p.queued--
+		p.running++
+		p.mu.Unlock()
+
+		job()
+
+		p.mu.Lock()
+		p.running--
+		if len(p.pending[key]) > 0 {
+			// Go to the back of the line so a busy chat can't starve the others
+			p.ready = append(p.ready, key)
+			p.cond.Signal()
+		} else {
+			delete(p.pending, key)
+			delete(p.active, key)
+		}
+		p.mu.Unlock()
+	}
+}
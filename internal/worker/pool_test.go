@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCloseDrains(t *testing.T) {
+	p := NewPool(2, 16)
+
+	var done atomic.Int32
+	for i := range 6 {
+		key := []string{"a", "b", "c"}[i%3]
+		p.Submit(key, func() {
+			time.Sleep(10 * time.Millisecond)
+			done.Add(1)
+		})
+	}
+
+	if err := p.Close(context.Background()); err != nil {
+		t.Fatalf("close failed: %s", err)
+	}
+	if n := done.Load(); n != 6 {
+		t.Errorf("expected every queued job to finish, got %d", n)
+	}
+	if p.Submit("a", func() {}) {
+		t.Errorf("closed pool should reject jobs")
+	}
+}
+
+func TestCloseTimeout(t *testing.T) {
+	p := NewPool(1, 1)
+	release := make(chan struct{})
+	defer close(release)
+	p.Submit("a", func() { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := p.Close(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestBackground(t *testing.T) {
+	parent, cancelParent := context.WithCancel(context.Background())
+	defer cancelParent()
+	b := NewBackground(parent)
+
+	var done atomic.Int32
+	b.Go(time.Minute, func(ctx context.Context) {
+		time.Sleep(10 * time.Millisecond)
+		done.Add(1)
+	})
+
+	stopped := make(chan error, 1)
+	b.Go(time.Minute, func(ctx context.Context) {
+		<-ctx.Done()
+		stopped <- ctx.Err()
+	})
+
+	timedOut := make(chan error, 1)
+	b.Go(10*time.Millisecond, func(ctx context.Context) {
+		<-ctx.Done()
+		timedOut <- ctx.Err()
+	})
+	if err := <-timedOut; err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected to give up on the blocked work, got %v", err)
+	}
+	if n := done.Load(); n != 1 {
+		t.Errorf("expected the short work to finish, got %d", n)
+	}
+	if b.Go(time.Minute, func(ctx context.Context) {}) {
+		t.Errorf("background should reject work after Wait")
+	}
+
+	cancelParent()
+	if err := <-stopped; err != context.Canceled {
+		t.Errorf("expected cancelling the parent to stop the work, got %v", err)
+	}
+	if err := b.Wait(context.Background()); err != nil {
+		t.Errorf("expected every work to be done, got %v", err)
+	}
+}
@@ -2,34 +2,57 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"flag"
 	"kano/internal/config"
 	"kano/internal/cronjobs"
 	"kano/internal/handler"
-	_ "kano/internal/message/handles" // Triggering the command aliases indexing
+	"kano/internal/message/handles"
+	"kano/internal/server"
+	"kano/internal/worker"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
+	_ "time/tzdata"
 
 	_ "github.com/lib/pq"
 	"github.com/netresearch/go-cron"
 	"go.mau.fi/whatsmeow"
 	"go.mau.fi/whatsmeow/store"
 	"go.mau.fi/whatsmeow/store/sqlstore"
+	"go.mau.fi/whatsmeow/types/events"
 	waLog "go.mau.fi/whatsmeow/util/log"
 )
 
+const (
+	workerCount     = 8
+	workerQueueSize = 256
+
+	// How long running handlers and cron jobs get to finish on shutdown
+	shutdownTimeout = 30 * time.Second
+)
+
 func main() {
+	exportHelp := flag.String("export-help", "", "write every command manual as an HTML page to this file and exit")
+	flag.Parse()
+
 	config.Init()
 	defer config.GetLogger().Close()
 
-	c := cron.New(cron.WithParser(cron.NewParser(
-		cron.SecondOptional | cron.Minute | cron.Hour |
-			cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
-	)))
+	if *exportHelp != "" {
+		if err := handles.ExportHelpFile(*exportHelp); err != nil {
+			panic(err)
+		}
+		config.GetLogger().Infof("Command manuals written to %s", *exportHelp)
+		return
+	}
+
+	conf := config.GetConfig()
+	c := cron.New(cron.WithParser(config.CronParser), cron.WithLocation(conf.Timezone))
 
 	dbLog := waLog.Stdout("Database", "DEBUG", true)
-	container, err := sqlstore.New(context.Background(), "postgres", config.GetConfig().DatabaseURL, dbLog)
+	container, err := sqlstore.New(context.Background(), "postgres", conf.DatabaseURL, dbLog)
 	if err != nil {
 		panic(err)
 	}
@@ -43,29 +66,112 @@ func main() {
 	clientLog := waLog.Stdout("Client", "ERROR", true)
 	client := whatsmeow.NewClient(deviceStore, clientLog)
 
-	var eventHandler = func(evt any) {
-		err = handler.Handle(client, evt)
+	// Cancelled when shutdown gives up waiting, so stuck handlers and cron
+	// jobs stop instead of being killed halfway
+	baseCtx, cancelAll := context.WithCancel(context.Background())
+	defer cancelAll()
+
+	var handleEvent = func(evt any) {
+		ctx, cancel := context.WithTimeout(baseCtx, config.GetConfig().HandlerTimeout)
+		defer cancel()
+
+		err := handler.Handle(ctx, client, evt)
 		if err != nil {
 			logger := config.GetLogger()
 			logger.Errorf("Event handler goes wrong: %s", err.Error())
 		}
 	}
 
+	// Messages go to the worker pool so a slow command doesn't block other
+	// events, other events are cheap enough to handle right away
+	pool := worker.NewPool(workerCount, workerQueueSize)
+	worker.SetDefault(pool)
+	// Handler work that outlives the handler timeout, e.g. `six update`
+	background := worker.NewBackground(baseCtx)
+	worker.SetBackground(background)
+	var eventHandler = func(evt any) {
+		msg, ok := evt.(*events.Message)
+		if !ok {
+			handleEvent(evt)
+			return
+		}
+
+		if !pool.Submit(msg.Info.Chat.String(), func() { handleEvent(evt) }) {
+			handler.Busy(client, msg)
+		}
+	}
+
 	client.AddEventHandler(eventHandler)
 
-	c.AddFunc("*/10 * * * * *", cronjobs.SixReminder(client))
-	id, err := c.AddFunc("@hourly", cronjobs.SixUpdateSchedules(client))
-	if err != nil {
+	if err := cronjobs.Schedule(baseCtx, c, "six-reminder", conf.CronSixReminder, cronjobs.SixReminder(client)); err != nil {
+		panic(err)
+	}
+	if err := cronjobs.Schedule(baseCtx, c, "six-update", conf.CronSixUpdate, cronjobs.SixUpdateSchedules(client)); err != nil {
+		panic(err)
+	}
+	if err := cronjobs.Schedule(baseCtx, c, "six-daily", conf.CronSixDaily, cronjobs.SixDailyDigest(client)); err != nil {
+		panic(err)
+	}
+	if err := cronjobs.Schedule(baseCtx, c, "six-weekly", conf.CronSixWeekly, cronjobs.SixWeeklyDigest(client)); err != nil {
 		panic(err)
 	}
-	fmt.Println("Registered with ID", id)
 
 	handler.Connect(client)
 	c.Start()
 
+	var srv *http.Server
+	if conf.AdminHTTPAddr != "" {
+		srv = server.Start(conf.AdminHTTPAddr, conf.AdminHTTPToken, client)
+	}
+
 	sign := make(chan os.Signal, 1)
 	signal.Notify(sign, os.Interrupt, syscall.SIGTERM)
 	<-sign
 
+	shutdown(client, c, pool, background, srv, cancelAll)
+}
+
+// Lets in-flight work finish before disconnecting so a redeploy doesn't cut a
+// handler or cron job in the middle of a transaction. A second signal skips
+// the waiting.
+func shutdown(client *whatsmeow.Client, c *cron.Cron, pool *worker.Pool, background *worker.Background, srv *http.Server, cancelAll context.CancelFunc) {
+	log := config.GetLogger()
+	log.Infof("Shutting down, waiting up to %s for running work", shutdownTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Waits for the event handlers that are running inline
+	client.RemoveEventHandlers()
+
+	if err := pool.Close(ctx); err != nil {
+		s := pool.Stats()
+		log.Warnf("Gave up waiting for handlers (%d running, %d queued): %s", s.Running, s.Queued, err)
+	}
+
+	// Started by the handlers above, so only waited for once they are done
+	if err := background.Wait(ctx); err != nil {
+		log.Warnf("Gave up waiting for background work: %s", err)
+	}
+
+	select {
+	case <-c.Stop().Done():
+	case <-ctx.Done():
+		log.Warnf("Gave up waiting for cron jobs: %s", ctx.Err())
+	}
+
+	// Whatever is still running gets its context cancelled
+	cancelAll()
+
+	if srv != nil {
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Warnf("Status server didn't shut down cleanly: %s", err)
+			srv.Close()
+		}
+	}
+
 	client.Disconnect()
+	log.Infof("Shutdown complete")
 }
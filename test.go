@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"kano/internal/database"
@@ -76,7 +77,7 @@ func dbgw() {
 func mysix() {
 	fmt.Println("Start")
 	a := time.Now()
-	subjs, err := six.GetAllSchedules()
+	subjs, err := six.GetAllSchedules(context.Background())
 	if err != nil {
 		panic(err)
 	}
@@ -126,7 +127,7 @@ func mysixDiff() {
 	}
 
 	fmt.Println("Applying diff")
-	err = schedules.ApplyDiff(diff)
+	err = schedules.ApplyDiff(context.Background(), diff)
 	if err != nil {
 		panic(err)
 	}
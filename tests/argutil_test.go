@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"kano/internal/utils/argutil"
+	"kano/internal/utils/parser"
+	"testing"
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestArgSchema(t *testing.T) {
+	schema := argutil.Schema{
+		Positional: []argutil.Spec{
+			{Name: "amount", Type: argutil.TypeInt, Required: true},
+			{Name: "target", Type: argutil.TypeMention, Required: true},
+		},
+		Named: []argutil.Spec{
+			{Name: "after", Type: argutil.TypeDuration},
+		},
+	}
+	prs := parser.Init([]string{"."})
+
+	tests := []struct {
+		Name        string
+		Input       string
+		ShouldError bool
+	}{
+		{"valid", ".sawit transfer 10 @12345 after=1d2h", false},
+		{"missing_target", ".sawit transfer 10", true},
+		{"invalid_amount", ".sawit transfer ten @12345", true},
+		{"invalid_mention", ".sawit transfer 10 12345", true},
+		{"invalid_duration", ".sawit transfer 10 @12345 after=soon", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			res, err := prs.Parse(tt.Input)
+			if err != nil {
+				t.Fatalf("failed to parse input: %s", err)
+			}
+
+			values, err := schema.Validate(res, 1)
+			if tt.ShouldError {
+				if err == nil {
+					t.Errorf("this should throw error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if amount, _ := argutil.Get[int64](values, "amount"); amount != 10 {
+				t.Errorf("expected amount 10, got %d", amount)
+			}
+			if target, _ := argutil.Get[types.JID](values, "target"); target.User != "12345" {
+				t.Errorf("expected target user 12345, got %s", target.User)
+			}
+			if after, _ := argutil.Get[time.Duration](values, "after"); after != 26*time.Hour {
+				t.Errorf("expected after 26h, got %s", after)
+			}
+		})
+	}
+}
+
+func TestParseClassCode(t *testing.T) {
+	valid := map[string]argutil.ClassCode{
+		"ET1201-01": {Code: "ET1201", Number: 1},
+		"ma1101-3":  {Code: "MA1101", Number: 3},
+	}
+	for input, expected := range valid {
+		got, err := argutil.ParseClassCode(input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", input, err)
+		} else if got != expected {
+			t.Errorf("%q: expected %+v, got %+v", input, expected, got)
+		}
+	}
+
+	for _, input := range []string{"ET1201", "ET120-01", "1T1201-01", "ETABCD-01", "ET1201-xx"} {
+		if _, err := argutil.ParseClassCode(input); err == nil {
+			t.Errorf("%q: this should throw error", input)
+		}
+	}
+}
+
+func TestParseClassCodes(t *testing.T) {
+	type result struct {
+		raw   string
+		class argutil.ClassCode
+		bad   bool
+	}
+	tests := []struct {
+		Name  string
+		Codes []string
+		Want  []result
+	}{
+		{
+			Name:  "single",
+			Codes: []string{"ET2202-01"},
+			Want:  []result{{raw: "ET2202-01", class: argutil.ClassCode{Code: "ET2202", Number: 1}}},
+		},
+		{
+			Name:  "keeps_order",
+			Codes: []string{"IF2110-02", "ET2202-01"},
+			Want: []result{
+				{raw: "IF2110-02", class: argutil.ClassCode{Code: "IF2110", Number: 2}},
+				{raw: "ET2202-01", class: argutil.ClassCode{Code: "ET2202", Number: 1}},
+			},
+		},
+		{
+			Name:  "drops_repeated_class",
+			Codes: []string{"ET2202-01", "et2202-1", "ET2202-02"},
+			Want: []result{
+				{raw: "ET2202-01", class: argutil.ClassCode{Code: "ET2202", Number: 1}},
+				{raw: "ET2202-02", class: argutil.ClassCode{Code: "ET2202", Number: 2}},
+			},
+		},
+		{
+			Name:  "reports_every_invalid_code",
+			Codes: []string{"nope", "ET2202-01", "nope", "ET22-01"},
+			Want: []result{
+				{raw: "nope", bad: true},
+				{raw: "ET2202-01", class: argutil.ClassCode{Code: "ET2202", Number: 1}},
+				{raw: "nope", bad: true},
+				{raw: "ET22-01", bad: true},
+			},
+		},
+		{
+			Name:  "empty",
+			Codes: nil,
+			Want:  []result{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			got := argutil.ParseClassCodes(tt.Codes)
+			if len(got) != len(tt.Want) {
+				t.Fatalf("expected %d codes, got %d: %+v", len(tt.Want), len(got), got)
+			}
+			for i, want := range tt.Want {
+				if got[i].Raw != want.raw {
+					t.Errorf("code %d: expected raw %q, got %q", i, want.raw, got[i].Raw)
+				}
+				if (got[i].Err != nil) != want.bad {
+					t.Errorf("code %d: expected invalid %t, got error %v", i, want.bad, got[i].Err)
+				}
+				if !want.bad && got[i].Class != want.class {
+					t.Errorf("code %d: expected %s, got %s", i, want.class, got[i].Class)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"kano/internal/utils/datetime"
+	"testing"
+	"time"
+)
+
+func TestNextMonday(t *testing.T) {
+	loc := time.FixedZone("WIB", 7*60*60)
+	tests := []struct {
+		Name     string
+		Now      time.Time
+		Expected time.Time
+	}{
+		{"sunday_evening", time.Date(2026, 2, 15, 19, 0, 0, 0, loc), time.Date(2026, 2, 16, 0, 0, 0, 0, loc)},
+		{"sunday_midnight", time.Date(2026, 2, 15, 0, 0, 0, 0, loc), time.Date(2026, 2, 16, 0, 0, 0, 0, loc)},
+		{"monday", time.Date(2026, 2, 16, 8, 0, 0, 0, loc), time.Date(2026, 2, 23, 0, 0, 0, 0, loc)},
+		{"wednesday", time.Date(2026, 2, 18, 12, 0, 0, 0, loc), time.Date(2026, 2, 23, 0, 0, 0, 0, loc)},
+		{"saturday", time.Date(2026, 2, 21, 23, 59, 0, 0, loc), time.Date(2026, 2, 23, 0, 0, 0, 0, loc)},
+		{"across_months", time.Date(2026, 2, 27, 10, 0, 0, 0, loc), time.Date(2026, 3, 2, 0, 0, 0, 0, loc)},
+		{"across_years", time.Date(2026, 12, 31, 10, 0, 0, 0, loc), time.Date(2027, 1, 4, 0, 0, 0, 0, loc)},
+		// 2026-02-15 20:00 UTC is already Monday in WIB
+		{"uses_time_location", time.Date(2026, 2, 15, 20, 0, 0, 0, time.UTC).In(loc), time.Date(2026, 2, 23, 0, 0, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := datetime.NextMonday(tt.Now); !got.Equal(tt.Expected) {
+				t.Errorf("expected %s, got %s", tt.Expected, got)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	loc := time.FixedZone("WIB", 7*60*60)
+	tests := []struct {
+		Name     string
+		Input    string
+		Expected time.Time
+		Ok       bool
+	}{
+		{"iso", "2026-03-01", time.Date(2026, 3, 1, 0, 0, 0, 0, loc), true},
+		{"day_first_dash", "01-03-2026", time.Date(2026, 3, 1, 0, 0, 0, 0, loc), true},
+		{"day_first_slash", "01/03/2026", time.Date(2026, 3, 1, 0, 0, 0, 0, loc), true},
+		{"leap_day", "29-02-2028", time.Date(2028, 2, 29, 0, 0, 0, 0, loc), true},
+		{"not_leap_year", "2026-02-29", time.Time{}, false},
+		{"month_out_of_range", "2026-13-01", time.Time{}, false},
+		{"unpadded", "1-3-2026", time.Time{}, false},
+		{"year_first_slash", "2026/03/01", time.Time{}, false},
+		{"word", "tomorrow", time.Time{}, false},
+		{"empty", "", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			got, ok := datetime.ParseDate(tt.Input, loc)
+			if ok != tt.Ok || !got.Equal(tt.Expected) {
+				t.Errorf("expected (%s, %t), got (%s, %t)", tt.Expected, tt.Ok, got, ok)
+			}
+			if ok && got.Location() != loc {
+				t.Errorf("expected location %s, got %s", loc, got.Location())
+			}
+		})
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	loc := time.FixedZone("WIB", 7*60*60)
+	tests := []struct {
+		Name     string
+		Time     time.Time
+		Loc      *time.Location
+		Expected time.Time
+	}{
+		{"monday", time.Date(2026, 2, 16, 8, 0, 0, 0, loc), loc, time.Date(2026, 2, 16, 0, 0, 0, 0, loc)},
+		{"sunday", time.Date(2026, 2, 22, 23, 59, 0, 0, loc), loc, time.Date(2026, 2, 16, 0, 0, 0, 0, loc)},
+		{"across_months", time.Date(2026, 3, 1, 10, 0, 0, 0, loc), loc, time.Date(2026, 2, 23, 0, 0, 0, 0, loc)},
+		// 2026-02-15 20:00 UTC is already Monday in WIB
+		{"uses_given_location", time.Date(2026, 2, 15, 20, 0, 0, 0, time.UTC), loc, time.Date(2026, 2, 16, 0, 0, 0, 0, loc)},
+		{"utc", time.Date(2026, 2, 15, 20, 0, 0, 0, time.UTC), time.UTC, time.Date(2026, 2, 9, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := datetime.StartOfWeek(tt.Time, tt.Loc); !got.Equal(tt.Expected) {
+				t.Errorf("expected %s, got %s", tt.Expected, got)
+			}
+		})
+	}
+}
@@ -450,3 +450,23 @@ func TestParseResult(t *testing.T) {
 		})
 	}
 }
+
+func TestWithPrefixes(t *testing.T) {
+	theParser := parser.Init([]string{"."}).WithPrefixes([]string{"@123 ", "!"})
+
+	for input, expected := range map[string]string{
+		"@123 ping":  "ping",
+		"@123  ping": "ping",
+		"!ping":      "ping",
+		".ping":      "",
+		"@1234 ping": "",
+	} {
+		res, err := theParser.Parse(input)
+		if err != nil {
+			t.Fatalf("%q: %s", input, err)
+		}
+		if res.Command.Name.Data != expected {
+			t.Errorf("%q: expected command %q, got %q", input, expected, res.Command.Name.Data)
+		}
+	}
+}
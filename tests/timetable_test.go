@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"kano/internal/utils/six/timetable"
+	"testing"
+	"time"
+)
+
+// Meeting of the class on 2026-02-16 from the given hours
+func meeting(class uint, start, end float64) timetable.Entry {
+	day := time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC)
+	return timetable.Entry{
+		Class: class,
+		Start: day.Add(time.Duration(start * float64(time.Hour))),
+		End:   day.Add(time.Duration(end * float64(time.Hour))),
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	at := func(hours float64) time.Time {
+		return time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC).Add(time.Duration(hours * float64(time.Hour)))
+	}
+
+	tests := []struct {
+		Name     string
+		Order    []uint
+		Entries  []timetable.Entry
+		Expected []timetable.Conflict
+	}{
+		{
+			Name:     "no_overlap",
+			Order:    []uint{1, 2},
+			Entries:  []timetable.Entry{meeting(1, 7, 9), meeting(2, 9, 11)},
+			Expected: []timetable.Conflict{},
+		},
+		{
+			Name:     "partial_overlap",
+			Order:    []uint{1, 2},
+			Entries:  []timetable.Entry{meeting(1, 7, 9), meeting(2, 8, 10)},
+			Expected: []timetable.Conflict{{Pair: timetable.Pair{A: 1, B: 2}, Count: 1, Start: at(8), End: at(9)}},
+		},
+		{
+			Name:     "contained_meeting_ends_the_overlap_early",
+			Order:    []uint{1, 2},
+			Entries:  []timetable.Entry{meeting(1, 7, 12), meeting(2, 8, 9)},
+			Expected: []timetable.Conflict{{Pair: timetable.Pair{A: 1, B: 2}, Count: 1, Start: at(8), End: at(9)}},
+		},
+		{
+			Name:     "pair_follows_the_given_order",
+			Order:    []uint{2, 1},
+			Entries:  []timetable.Entry{meeting(1, 7, 9), meeting(2, 8, 10)},
+			Expected: []timetable.Conflict{{Pair: timetable.Pair{A: 2, B: 1}, Count: 1, Start: at(8), End: at(9)}},
+		},
+		{
+			Name:     "unsorted_entries",
+			Order:    []uint{1, 2},
+			Entries:  []timetable.Entry{meeting(2, 8, 10), meeting(1, 7, 9)},
+			Expected: []timetable.Conflict{{Pair: timetable.Pair{A: 1, B: 2}, Count: 1, Start: at(8), End: at(9)}},
+		},
+		{
+			Name:     "meetings_of_the_same_class_are_ignored",
+			Order:    []uint{1},
+			Entries:  []timetable.Entry{meeting(1, 7, 9), meeting(1, 8, 10)},
+			Expected: []timetable.Conflict{},
+		},
+		{
+			Name:  "counts_every_overlap_and_keeps_the_first",
+			Order: []uint{1, 2},
+			Entries: []timetable.Entry{
+				meeting(1, 7, 9), meeting(2, 8, 10),
+				meeting(1, 13, 15), meeting(2, 14, 16),
+			},
+			Expected: []timetable.Conflict{{Pair: timetable.Pair{A: 1, B: 2}, Count: 2, Start: at(8), End: at(9)}},
+		},
+		{
+			Name:  "pairs_listed_by_first_overlap",
+			Order: []uint{1, 2, 3},
+			Entries: []timetable.Entry{
+				meeting(3, 7, 9), meeting(1, 8, 10),
+				meeting(2, 13, 15), meeting(3, 14, 16),
+			},
+			Expected: []timetable.Conflict{
+				{Pair: timetable.Pair{A: 1, B: 3}, Count: 1, Start: at(8), End: at(9)},
+				{Pair: timetable.Pair{A: 2, B: 3}, Count: 1, Start: at(14), End: at(15)},
+			},
+		},
+		{
+			Name:  "sweep_continues_past_a_short_meeting",
+			Order: []uint{1, 2, 3},
+			Entries: []timetable.Entry{
+				meeting(1, 7, 12), meeting(2, 8, 9), meeting(3, 10, 11),
+			},
+			Expected: []timetable.Conflict{
+				{Pair: timetable.Pair{A: 1, B: 2}, Count: 1, Start: at(8), End: at(9)},
+				{Pair: timetable.Pair{A: 1, B: 3}, Count: 1, Start: at(10), End: at(11)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			got := timetable.Conflicts(tt.Order, tt.Entries)
+			if len(got) != len(tt.Expected) {
+				t.Fatalf("expected %d conflicts, got %d: %+v", len(tt.Expected), len(got), got)
+			}
+			for i, want := range tt.Expected {
+				g := got[i]
+				if g.Pair != want.Pair || g.Count != want.Count || !g.Start.Equal(want.Start) || !g.End.Equal(want.End) {
+					t.Errorf("conflict %d: expected %+v, got %+v", i, want, g)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"kano/internal/utils/word"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"wordle", "wordle", 0},
+		{"", "ping", 4},
+		{"worlde", "wordle", 1},
+		{"wrodle", "wordle", 1},
+		{"pign", "ping", 1},
+		{"stikcer", "stk", 4},
+		{"kitten", "sitting", 3},
+		{"dowload", "download", 1},
+	}
+
+	for _, tt := range tests {
+		if got := word.Distance(tt.a, tt.b); got != tt.expected {
+			t.Errorf("Distance(%q, %q): expected %d, got %d", tt.a, tt.b, tt.expected, got)
+		}
+	}
+}
+
+func TestKeycapDigit(t *testing.T) {
+	tests := []struct {
+		emoji string
+		digit int
+		ok    bool
+	}{
+		{"1️⃣", 1, true},
+		{"9️⃣", 9, true},
+		{"0️⃣", 0, true},
+		// Without the variation selector
+		{"3⃣", 3, true},
+		{"#️⃣", 0, false},
+		{"12⃣", 0, false},
+		{"\U0001F51F", 0, false},
+		{"5", 0, false},
+		{"\U0001F44D", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		if digit, ok := word.KeycapDigit(tt.emoji); digit != tt.digit || ok != tt.ok {
+			t.Errorf("KeycapDigit(%q): expected (%d, %t), got (%d, %t)", tt.emoji, tt.digit, tt.ok, digit, ok)
+		}
+	}
+}